@@ -58,6 +58,23 @@ func (r absentPtrRule[T]) Validate(p *T) error {
 	return nil
 }
 
+type absentNullableRule[T any] struct {
+	condition bool
+}
+
+func NilNullable[T any](condition bool) absentNullableRule[T] {
+	return absentNullableRule[T]{
+		condition: condition,
+	}
+}
+
+func (r absentNullableRule[T]) Validate(_ T, valid bool) error {
+	if r.condition && valid {
+		return ErrNil
+	}
+	return nil
+}
+
 type absentSliceRule[T any] struct {
 	condition bool
 	checkNil  bool
@@ -0,0 +1,126 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/infastin/go-validation/constraints"
+)
+
+var ErrSumOverflow = NewRuleError("sum_overflow", "the sum must not overflow")
+
+type aggregateSliceRule[T any, N constraints.Number] struct {
+	// aggregate reports false if there is nothing to validate.
+	aggregate  func(s []T) (N, bool, error)
+	rules      []NumberRule[N]
+	buildError func(v N, err error) error
+}
+
+// SumSlice validates the sum of the projected values with rules.
+// An integer sum that overflows N fails with ErrSumOverflow.
+//
+// Methods cannot have type parameters, so SumSlice, MinSlice and MaxSlice
+// have no SliceValidator builders: use By(SumSlice(project, rules...)).
+func SumSlice[T any, N constraints.Number](project func(v T) N, rules ...NumberRule[N]) aggregateSliceRule[T, N] {
+	return aggregateSliceRule[T, N]{
+		aggregate: func(s []T) (N, bool, error) {
+			var sum N
+			for i := range s {
+				v := project(s[i])
+				next := sum + v
+				if v > 0 && next < sum || v < 0 && next > sum {
+					return 0, false, ErrSumOverflow
+				}
+				sum = next
+			}
+			return sum, true, nil
+		},
+		rules: rules,
+		buildError: func(v N, err error) error {
+			return buildAggregateError("sum", "sum", v, err)
+		},
+	}
+}
+
+// MinSlice validates the minimum of the projected values with rules.
+// An empty slice has no minimum and is not validated.
+func MinSlice[T any, N constraints.Number](project func(v T) N, rules ...NumberRule[N]) aggregateSliceRule[T, N] {
+	return aggregateSliceRule[T, N]{
+		aggregate: func(s []T) (N, bool, error) {
+			if len(s) == 0 {
+				return 0, false, nil
+			}
+			res := project(s[0])
+			for i := 1; i < len(s); i++ {
+				res = min(res, project(s[i]))
+			}
+			return res, true, nil
+		},
+		rules: rules,
+		buildError: func(v N, err error) error {
+			return buildAggregateError("min", "minimum", v, err)
+		},
+	}
+}
+
+// MaxSlice validates the maximum of the projected values with rules.
+// An empty slice has no maximum and is not validated.
+func MaxSlice[T any, N constraints.Number](project func(v T) N, rules ...NumberRule[N]) aggregateSliceRule[T, N] {
+	return aggregateSliceRule[T, N]{
+		aggregate: func(s []T) (N, bool, error) {
+			if len(s) == 0 {
+				return 0, false, nil
+			}
+			res := project(s[0])
+			for i := 1; i < len(s); i++ {
+				res = max(res, project(s[i]))
+			}
+			return res, true, nil
+		},
+		rules: rules,
+		buildError: func(v N, err error) error {
+			return buildAggregateError("max", "maximum", v, err)
+		},
+	}
+}
+
+func CountSlice[T any](pred func(v T) bool, rules ...NumberRule[int]) aggregateSliceRule[T, int] {
+	return aggregateSliceRule[T, int]{
+		aggregate: func(s []T) (int, bool, error) {
+			count := 0
+			for i := range s {
+				if pred(s[i]) {
+					count++
+				}
+			}
+			return count, true, nil
+		},
+		rules: rules,
+		buildError: func(v int, err error) error {
+			return buildAggregateError("count", "count", v, err)
+		},
+	}
+}
+
+func (r aggregateSliceRule[T, N]) Validate(s []T) error {
+	v, ok, err := r.aggregate(s)
+	if !ok {
+		return err
+	}
+	for _, rule := range r.rules {
+		if err := rule.Validate(v); err != nil {
+			return r.buildError(v, err)
+		}
+	}
+	return nil
+}
+
+func buildAggregateError(code, noun string, v any, err error) error {
+	var message string
+	if re, ok := err.(RuleError); ok {
+		code += "_" + re.Code()
+		message = re.Message()
+	} else {
+		message = err.Error()
+	}
+	return NewRuleError(code, fmt.Sprintf("the %s (%v) %s", noun, v, message))
+}
@@ -0,0 +1,96 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/infastin/go-validation"
+)
+
+type allocation struct {
+	percent float64
+	primary bool
+}
+
+func Test_SumSlice_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		slice   []allocation
+		want    string
+		wantErr bool
+	}{
+		{"exact", []allocation{{40, false}, {60, false}}, "", false},
+		{"below", []allocation{{40, false}, {50, false}}, "the sum (90) must be equal to 100", true},
+		{"empty", nil, "the sum (0) must be equal to 100", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validation.SumSlice(func(v allocation) float64 { return v.percent }, validation.Equal(100.0))
+			got := r.Validate(tt.slice)
+			if (got != nil) != tt.wantErr {
+				t.Errorf("SumSlice.Validate() error = %v, wantErr %v", got, tt.wantErr)
+				return
+			}
+			if got != nil && got.Error() != tt.want {
+				t.Errorf("SumSlice.Validate() = %v, want %v", got.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_CountSlice_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		slice    []allocation
+		wantCode string
+		want     string
+		wantErr  bool
+	}{
+		{"none", []allocation{{40, false}, {60, false}}, "", "", false},
+		{"one", []allocation{{40, true}, {60, false}}, "", "", false},
+		{"two", []allocation{{40, true}, {60, true}}, "count_less_equal", "the count (2) must be no greater than 1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validation.CountSlice(func(v allocation) bool { return v.primary }, validation.LessEqual(1))
+			got := r.Validate(tt.slice)
+			if (got != nil) != tt.wantErr {
+				t.Errorf("CountSlice.Validate() error = %v, wantErr %v", got, tt.wantErr)
+				return
+			}
+			if got == nil {
+				return
+			}
+			if got.Error() != tt.want {
+				t.Errorf("CountSlice.Validate() = %v, want %v", got.Error(), tt.want)
+			}
+			if code := got.(validation.RuleError).Code(); code != tt.wantCode {
+				t.Errorf("CountSlice.Validate() code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func Test_MaxSlice_Validate(t *testing.T) {
+	r := validation.MaxSlice(func(v allocation) float64 { return v.percent }, validation.LessEqual(50.0))
+	if got := r.Validate(nil); got != nil {
+		t.Errorf("MaxSlice.Validate() = %v, want nil", got)
+	}
+	want := "the maximum (60) must be no greater than 50"
+	if got := r.Validate([]allocation{{40, false}, {60, false}}); got == nil || got.Error() != want {
+		t.Errorf("MaxSlice.Validate() = %v, want %v", got, want)
+	}
+}
+
+func Test_SumSlice_Overflow(t *testing.T) {
+	r := validation.SumSlice(func(v uint8) uint8 { return v })
+	if got := r.Validate([]uint8{200, 55}); got != nil {
+		t.Errorf("SumSlice.Validate() = %v, want nil", got)
+	}
+	if got := r.Validate([]uint8{200, 100}); got != validation.ErrSumOverflow {
+		t.Errorf("SumSlice.Validate() = %v, want %v", got, validation.ErrSumOverflow)
+	}
+	s := validation.SumSlice(func(v int8) int8 { return v })
+	if got := s.Validate([]int8{-100, -29}); got != validation.ErrSumOverflow {
+		t.Errorf("SumSlice.Validate() = %v, want %v", got, validation.ErrSumOverflow)
+	}
+}
@@ -150,7 +150,7 @@ func (av AnyValidator[T]) BetweenEqual(cmp func(a, b T) int, a, b T) AnyValidato
 
 func (av AnyValidator[T]) With(fns ...func(v T) error) AnyValidator[T] {
 	if av.scope.Ok() {
-		slices.Grow(av.rules, len(fns))
+		av.rules = slices.Grow(av.rules, len(fns))
 		for _, fn := range fns {
 			av.rules = append(av.rules, AnyRuleFunc[T](fn))
 		}
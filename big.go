@@ -0,0 +1,226 @@
+package validation
+
+import (
+	"slices"
+
+	"github.com/infastin/go-validation/constraints"
+)
+
+type bigValidatorData[T constraints.Big[T]] struct {
+	value T
+	name  string
+}
+
+type BigValidator[T constraints.Big[T]] struct {
+	data  *bigValidatorData[T]
+	rules []BigRule[T]
+	scope validatorScope
+}
+
+func Big[T constraints.Big[T]](n T, name string) BigValidator[T] {
+	return BigValidator[T]{
+		data: &bigValidatorData[T]{
+			value: n,
+			name:  name,
+		},
+		rules: make([]BigRule[T], 0),
+		scope: nil,
+	}
+}
+
+func BigI[T constraints.Big[T]](n T) BigValidator[T] {
+	return BigValidator[T]{
+		data: &bigValidatorData[T]{
+			value: n,
+			name:  "",
+		},
+		rules: make([]BigRule[T], 0),
+		scope: nil,
+	}
+}
+
+func BigV[T constraints.Big[T]]() BigValidator[T] {
+	return BigValidator[T]{
+		data:  nil,
+		rules: make([]BigRule[T], 0),
+		scope: nil,
+	}
+}
+
+func (bv BigValidator[T]) If(condition bool) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.scope = bv.scope.Push(condition)
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) ElseIf(condition bool) BigValidator[T] {
+	if !bv.scope.Ok() {
+		bv.scope.Set(condition)
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Else() BigValidator[T] {
+	if !bv.scope.Ok() {
+		bv.scope.Set(true)
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Break(condition bool) BigValidator[T] {
+	if !bv.scope.Empty() && condition {
+		bv.scope.Set(false)
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) EndIf() BigValidator[T] {
+	if !bv.scope.Empty() {
+		bv.scope = bv.scope.Pop()
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Required(condition bool) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, RequiredBig[T](condition))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) In(elements ...T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, InBig(elements...))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) NotIn(elements ...T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, NotInBig(elements...))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Equal(v T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, EqualBig(v))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Less(v T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, LessBig(v))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) LessEqual(v T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, LessEqualBig(v))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Greater(v T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, GreaterBig(v))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) GreaterEqual(v T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, GreaterEqualBig(v))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Between(a, b T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, BetweenBig(a, b))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) BetweenEqual(a, b T) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, BetweenEqualBig(a, b))
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Positive() BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, PositiveBig[T]())
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Negative() BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, NegativeBig[T]())
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) NonZero() BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, NonZeroBig[T]())
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) NonNegative() BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, NonNegativeBig[T]())
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) NonPositive() BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, NonPositiveBig[T]())
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) With(fns ...func(n T) error) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = slices.Grow(bv.rules, len(fns))
+		for _, fn := range fns {
+			bv.rules = append(bv.rules, BigRuleFunc[T](fn))
+		}
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) By(rules ...BigRule[T]) BigValidator[T] {
+	if bv.scope.Ok() {
+		bv.rules = append(bv.rules, rules...)
+	}
+	return bv
+}
+
+func (bv BigValidator[T]) Valid() error {
+	for _, rule := range bv.rules {
+		if err := rule.Validate(bv.data.value); err != nil {
+			if bv.data.name != "" {
+				err = NewValueError(bv.data.name, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (bv BigValidator[T]) Validate(v T) error {
+	for _, rule := range bv.rules {
+		if err := rule.Validate(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package validation
+
+import (
+	"math/big"
+	"strconv"
+)
+
+type bitLenBigIntRule struct {
+	max        int
+	buildError func() error
+}
+
+func MaxBitLenBigInt(max int) bitLenBigIntRule {
+	return bitLenBigIntRule{
+		max: max,
+		buildError: func() error {
+			return buildBitLenError(max)
+		},
+	}
+}
+
+func (r bitLenBigIntRule) Validate(n *big.Int) error {
+	if n != nil && n.BitLen() > r.max {
+		return r.buildError()
+	}
+	return nil
+}
+
+type digitsBigIntRule struct {
+	max        int
+	buildError func() error
+}
+
+func MaxDigitsBigInt(max int) digitsBigIntRule {
+	return digitsBigIntRule{
+		max: max,
+		buildError: func() error {
+			return buildDigitsError(max)
+		},
+	}
+}
+
+func (r digitsBigIntRule) Validate(n *big.Int) error {
+	if n == nil {
+		return nil
+	}
+	digits := len(n.Text(10))
+	if n.Sign() < 0 {
+		digits--
+	}
+	if digits > r.max {
+		return r.buildError()
+	}
+	return nil
+}
+
+type scaleBigRatRule struct {
+	max        int
+	buildError func() error
+}
+
+func MaxScaleBigRat(max int) scaleBigRatRule {
+	return scaleBigRatRule{
+		max: max,
+		buildError: func() error {
+			return buildDecimalPlacesError(max)
+		},
+	}
+}
+
+func (r scaleBigRatRule) Validate(n *big.Rat) error {
+	if n != nil && !hasMaxScale(n.Denom(), r.max) {
+		return r.buildError()
+	}
+	return nil
+}
+
+// hasMaxScale reports whether 1/d can be written with at most max
+// decimal places, i.e. d divides 10^max.
+func hasMaxScale(d *big.Int, max int) bool {
+	twos := int(d.TrailingZeroBits())
+	if twos > max {
+		return false
+	}
+
+	var (
+		q    = new(big.Int).Rsh(d, uint(twos))
+		m    = new(big.Int)
+		five = big.NewInt(5)
+	)
+
+	for fives := 0; q.Cmp(bigOne) != 0; fives++ {
+		if fives == max {
+			return false
+		}
+		if q.QuoRem(q, five, m); m.Sign() != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+var bigOne = big.NewInt(1)
+
+func buildBitLenError(max int) error {
+	return NewRuleError("bit_length_too_long", "the bit length must be no more than "+strconv.Itoa(max))
+}
+
+func buildDigitsError(max int) error {
+	return NewRuleError("too_many_digits", "must have no more than "+strconv.Itoa(max)+" digits")
+}
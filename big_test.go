@@ -0,0 +1,42 @@
+package validation_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_BigValidator_Valid(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		name      string
+		validator validation.Validator
+		want      string
+	}{
+		{"int between", validation.Big(big.NewInt(5), "n").BetweenEqual(big.NewInt(1), big.NewInt(10)), ""},
+		{"int less", validation.Big(huge, "n").Less(big.NewInt(10)), "n: must be less than 10"},
+		{"int digits", validation.Big(huge, "n").By(validation.MaxDigitsBigInt(20)), "n: must have no more than 20 digits"},
+		{"int bit length", validation.Big(big.NewInt(256), "n").By(validation.MaxBitLenBigInt(8)), "n: the bit length must be no more than 8"},
+		{"int nil", validation.Big[*big.Int](nil, "n").Positive().Less(big.NewInt(10)), ""},
+		{"int required", validation.Big[*big.Int](nil, "n").Required(true), "n: cannot be blank"},
+		{"rat greater", validation.Big(big.NewRat(1, 3), "r").GreaterEqual(big.NewRat(1, 2)), "r: must be no less than 1/2"},
+		{"rat in", validation.Big(big.NewRat(2, 4), "r").In(big.NewRat(1, 2), big.NewRat(1, 1)), ""},
+		{"rat scale", validation.Big(big.NewRat(1, 8), "r").By(validation.MaxScaleBigRat(3)), ""},
+		{"rat scale exceeded", validation.Big(big.NewRat(1, 8), "r").By(validation.MaxScaleBigRat(2)), "r: must have no more than 2 decimal places"},
+		{"rat not terminating", validation.Big(big.NewRat(1, 3), "r").By(validation.MaxScaleBigRat(10)), "r: must have no more than 10 decimal places"},
+		{"float negative", validation.Big(big.NewFloat(0.5), "f").Negative(), "f: must be negative"},
+		{"float bound", validation.Big(big.NewFloat(2), "f").LessEqual(big.NewFloat(1.25)), "f: must be no greater than 1.25"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.validator.Valid(); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("BigValidator.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
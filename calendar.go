@@ -0,0 +1,165 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrWeekday = NewRuleError("weekday", "must be a weekday")
+	ErrNotUTC  = NewRuleError("not_utc", "must have a non-UTC location")
+)
+
+type calendarTimeRule struct {
+	loc        *time.Location
+	comp       func(x time.Time) bool
+	buildError func() error
+}
+
+func WeekdayTime(loc *time.Location) calendarTimeRule {
+	return calendarTimeRule{
+		loc: loc,
+		comp: func(x time.Time) bool {
+			wd := x.Weekday()
+			return wd != time.Saturday && wd != time.Sunday
+		},
+		buildError: func() error {
+			return ErrWeekday
+		},
+	}
+}
+
+func DaysOfWeekTime(loc *time.Location, days ...time.Weekday) calendarTimeRule {
+	return calendarTimeRule{
+		loc: loc,
+		comp: func(x time.Time) bool {
+			wd := x.Weekday()
+			for _, day := range days {
+				if wd == day {
+					return true
+				}
+			}
+			return false
+		},
+		buildError: func() error {
+			return buildDaysOfWeekError(days)
+		},
+	}
+}
+
+func TimeOfDayTime(loc *time.Location, from, to time.Duration) calendarTimeRule {
+	return calendarTimeRule{
+		loc: loc,
+		comp: func(x time.Time) bool {
+			h, m, s := x.Clock()
+			tod := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+				time.Duration(s)*time.Second + time.Duration(x.Nanosecond())
+			if from <= to {
+				return tod >= from && tod < to
+			}
+			return tod >= from || tod < to
+		},
+		buildError: func() error {
+			return buildTimeOfDayError(from, to)
+		},
+	}
+}
+
+func AlignedTime(loc *time.Location, d time.Duration) calendarTimeRule {
+	return calendarTimeRule{
+		loc: loc,
+		comp: func(x time.Time) bool {
+			_, offset := x.Zone()
+			wall := x.Add(time.Duration(offset) * time.Second)
+			return wall.Truncate(d).Equal(wall)
+		},
+		buildError: func() error {
+			return buildAlignedError(d)
+		},
+	}
+}
+
+func NotUTCTime() calendarTimeRule {
+	return calendarTimeRule{
+		loc: nil,
+		comp: func(x time.Time) bool {
+			return x.Location() != time.UTC
+		},
+		buildError: func() error {
+			return ErrNotUTC
+		},
+	}
+}
+
+func SameDayTime(v time.Time, loc *time.Location) calendarTimeRule {
+	if loc != nil {
+		v = v.In(loc)
+	}
+	vy, vm, vd := v.Date()
+	return calendarTimeRule{
+		loc: v.Location(),
+		comp: func(x time.Time) bool {
+			y, m, d := x.Date()
+			return y == vy && m == vm && d == vd
+		},
+		buildError: func() error {
+			return buildSameDayError(v)
+		},
+	}
+}
+
+func EqualTruncatedTime(v time.Time, d time.Duration) calendarTimeRule {
+	return calendarTimeRule{
+		loc: nil,
+		comp: func(x time.Time) bool {
+			return x.Truncate(d).Equal(v.Truncate(d))
+		},
+		buildError: func() error {
+			return buildEqualError(v.Truncate(d))
+		},
+	}
+}
+
+func (r calendarTimeRule) Validate(t time.Time) error {
+	if r.loc != nil {
+		t = t.In(r.loc)
+	}
+	if !r.comp(t) {
+		return r.buildError()
+	}
+	return nil
+}
+
+func buildDaysOfWeekError(days []time.Weekday) error {
+	var message strings.Builder
+	message.WriteString("must be on ")
+	for i, day := range days {
+		if i != 0 {
+			message.WriteString(", ")
+		}
+		message.WriteString(day.String())
+	}
+	return NewRuleError("days_of_week", message.String())
+}
+
+func buildTimeOfDayError(from, to time.Duration) error {
+	return NewRuleError("time_of_day", fmt.Sprintf("must be between %s and %s",
+		formatTimeOfDay(from), formatTimeOfDay(to)))
+}
+
+func buildAlignedError(d time.Duration) error {
+	return NewRuleError("aligned", fmt.Sprintf("must be aligned to %v", d))
+}
+
+func buildSameDayError(v time.Time) error {
+	return NewRuleError("same_day", "must be on "+v.Format(time.DateOnly))
+}
+
+func formatTimeOfDay(d time.Duration) string {
+	t := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(d)
+	if t.Second() != 0 || t.Nanosecond() != 0 {
+		return t.Format(time.TimeOnly)
+	}
+	return t.Format("15:04")
+}
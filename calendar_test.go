@@ -0,0 +1,56 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_Calendar_Validate(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database is not available")
+	}
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip("time zone database is not available")
+	}
+
+	// 2024-03-15 is a Friday.
+	friday := time.Date(2024, 3, 15, 22, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		rule validation.TimeRule
+		t    time.Time
+		want string
+	}{
+		{"weekday", validation.WeekdayTime(nil), friday, ""},
+		{"weekend in location", validation.WeekdayTime(berlin), friday.Add(2 * time.Hour), "must be a weekday"},
+		{"days of week", validation.DaysOfWeekTime(nil, time.Monday, time.Wednesday), friday, "must be on Monday, Wednesday"},
+		{"time of day", validation.TimeOfDayTime(berlin, 9*time.Hour, 18*time.Hour), friday.Add(-10 * time.Hour), ""},
+		{"time of day in location", validation.TimeOfDayTime(berlin, 9*time.Hour, 18*time.Hour), friday.Add(-5 * time.Hour), "must be between 09:00 and 18:00"},
+		{"time of day overnight", validation.TimeOfDayTime(nil, 22*time.Hour, 6*time.Hour), friday, ""},
+		{"aligned", validation.AlignedTime(nil, 15*time.Minute), friday, ""},
+		{"not aligned", validation.AlignedTime(nil, 15*time.Minute), friday.Add(time.Minute), "must be aligned to 15m0s"},
+		{"aligned in location", validation.AlignedTime(kolkata, time.Hour), time.Date(2024, 3, 15, 9, 0, 0, 0, kolkata), ""},
+		{"not aligned in location", validation.AlignedTime(nil, time.Hour), time.Date(2024, 3, 15, 9, 30, 0, 0, kolkata), "must be aligned to 1h0m0s"},
+		{"not utc", validation.NotUTCTime(), friday.In(berlin), ""},
+		{"utc", validation.NotUTCTime(), friday, "must have a non-UTC location"},
+		{"same day", validation.SameDayTime(friday, nil), friday.Add(-22 * time.Hour), ""},
+		{"other day in location", validation.SameDayTime(friday, berlin), friday.Add(2 * time.Hour), "must be on 2024-03-15"},
+		{"equal truncated", validation.EqualTruncatedTime(friday, time.Hour), friday.Add(-30 * time.Minute), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.rule.Validate(tt.t); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package checkdigit
+
+// Luhn reports whether s consists of digits
+// and ends with a valid Luhn check digit.
+func Luhn[S ~string | ~[]byte](s S) bool {
+	if len(s) < 2 {
+		return false
+	}
+	sum := 0
+	for i := range len(s) {
+		c := s[len(s)-1-i]
+		if !isDigit(c) {
+			return false
+		}
+		d := int(c - '0')
+		if i%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// Mod10 reports whether s ends with a valid check digit for a weighted
+// sum modulo 10. The weights are applied cyclically to the characters
+// preceding the check digit, starting from the rightmost one, and the
+// check digit has a weight of one. Letters in upper case count as the
+// numbers 10 to 35. For example, EAN and UPC codes use the weights 3, 1.
+func Mod10[S ~string | ~[]byte](s S, weights ...int) bool {
+	if len(s) < 2 || len(weights) == 0 || !isDigit(s[len(s)-1]) {
+		return false
+	}
+	sum := int(s[len(s)-1] - '0')
+	for i := range len(s) - 1 {
+		v, ok := value(s[len(s)-2-i])
+		if !ok {
+			return false
+		}
+		sum += weights[i%len(weights)] * v
+	}
+	return sum%10 == 0
+}
+
+// Mod11 reports whether s consists of digits and ends with a valid
+// check digit for a sum modulo 11 with the weights 1, 2, 3 and so on,
+// starting from the rightmost digit. The check digit may be 'X',
+// which stands for 10, as in ISBN-10 and ISSN.
+func Mod11[S ~string | ~[]byte](s S) bool {
+	if len(s) < 2 {
+		return false
+	}
+	sum := 0
+	if c := s[len(s)-1]; c == 'X' {
+		sum = 10
+	} else if isDigit(c) {
+		sum = int(c - '0')
+	} else {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		c := s[len(s)-1-i]
+		if !isDigit(c) {
+			return false
+		}
+		sum += (i + 1) * int(c-'0')
+	}
+	return sum%11 == 0
+}
+
+// Mod97 returns the remainder of the number formed by the digits of s
+// divided by 97, where letters in upper case are replaced by the numbers
+// 10 to 35, as described by ISO 7064 MOD 97-10. The remainder is 1
+// for valid IBANs and LEIs.
+func Mod97[S ~string | ~[]byte](s S) (int, bool) {
+	r := 0
+	for i := range len(s) {
+		v, ok := value(s[i])
+		if !ok {
+			return 0, false
+		}
+		if v < 10 {
+			r = (r*10 + v) % 97
+		} else {
+			r = (r*100 + v) % 97
+		}
+	}
+	return r, true
+}
+
+// Verhoeff reports whether s consists of digits
+// and ends with a valid Verhoeff check digit.
+func Verhoeff[S ~string | ~[]byte](s S) bool {
+	if len(s) < 2 {
+		return false
+	}
+	var c byte
+	for i := range len(s) {
+		d := s[len(s)-1-i]
+		if !isDigit(d) {
+			return false
+		}
+		c = verhoeffD[c][verhoeffP[i%8][d-'0']]
+	}
+	return c == 0
+}
+
+var verhoeffD = [10][10]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+var verhoeffP = [8][10]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func value(c byte) (int, bool) {
+	switch {
+	case isDigit(c):
+		return int(c - '0'), true
+	case 'A' <= c && c <= 'Z':
+		return int(c-'A') + 10, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,121 @@
+package checkdigit_test
+
+import (
+	"testing"
+
+	"github.com/infastin/go-validation/checkdigit"
+)
+
+func Test_Luhn(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{"card", "4111111111111111", true},
+		{"short", "18", true},
+		{"checksum", "4111111111111112", false},
+		{"letters", "411111111111111A", false},
+		{"single digit", "0", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkdigit.Luhn(tt.s); got != tt.want {
+				t.Errorf("Luhn() = %v, want %v", got, tt.want)
+			}
+			if got := checkdigit.Luhn([]byte(tt.s)); got != tt.want {
+				t.Errorf("Luhn([]byte) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Mod10(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		weights []int
+		want    bool
+	}{
+		{"ean-13", "4006381333931", []int{3, 1}, true},
+		{"upc-a", "036000291452", []int{3, 1}, true},
+		{"sedol with letters", "B0YBKJ7", []int{9, 3, 7, 1, 3, 1}, true},
+		{"checksum", "4006381333932", []int{3, 1}, false},
+		{"letter check digit", "400638133393A", []int{3, 1}, false},
+		{"lower case", "b0ybkj7", []int{9, 3, 7, 1, 3, 1}, false},
+		{"no weights", "4006381333931", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkdigit.Mod10(tt.s, tt.weights...); got != tt.want {
+				t.Errorf("Mod10() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Mod11(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{"isbn-10", "0306406152", true},
+		{"check digit x", "080442957X", true},
+		{"issn", "03785955", true},
+		{"checksum", "0306406153", false},
+		{"x in the middle", "03064X6152", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkdigit.Mod11(tt.s); got != tt.want {
+				t.Errorf("Mod11() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Mod97(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		want   int
+		wantOk bool
+	}{
+		{"rearranged iban", "370400440532013000DE89", 1, true},
+		{"lei", "529900T8BM49AURSDO55", 1, true},
+		{"digits", "194", 0, true},
+		{"empty", "", 0, true},
+		{"lower case", "de89", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := checkdigit.Mod97(tt.s)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Mod97() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func Test_Verhoeff(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want bool
+	}{
+		{"valid", "2363", true},
+		{"aadhaar", "234123412346", true},
+		{"checksum", "2364", false},
+		{"transposition", "3263", false},
+		{"letters", "23A3", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkdigit.Verhoeff(tt.s); got != tt.want {
+				t.Errorf("Verhoeff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package civil
+
+import (
+	"cmp"
+	"errors"
+	"time"
+)
+
+var ErrInvalidDate = errors.New("civil: invalid date")
+
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{
+		Year:  y,
+		Month: m,
+		Day:   d,
+	}
+}
+
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, ErrInvalidDate
+	}
+	return DateOf(t), nil
+}
+
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+func (d Date) IsValid() bool {
+	return DateOf(d.In(time.UTC)) == d
+}
+
+func (d Date) IsZero() bool {
+	return d.Year == 0 && d.Month == 0 && d.Day == 0
+}
+
+func (d Date) AddDays(n int) Date {
+	return DateOf(d.In(time.UTC).AddDate(0, 0, n))
+}
+
+func (d Date) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+func (d Date) Compare(u Date) int {
+	switch {
+	case d.Year != u.Year:
+		return cmp.Compare(d.Year, u.Year)
+	case d.Month != u.Month:
+		return cmp.Compare(d.Month, u.Month)
+	default:
+		return cmp.Compare(d.Day, u.Day)
+	}
+}
+
+func (d Date) Before(u Date) bool {
+	return d.Compare(u) < 0
+}
+
+func (d Date) After(u Date) bool {
+	return d.Compare(u) > 0
+}
+
+func (d Date) String() string {
+	return string(d.AppendFormat(make([]byte, 0, len(time.DateOnly))))
+}
+
+// AppendFormat appends the date in the YYYY-MM-DD format.
+// The fields are formatted as they are, so an invalid date such as
+// February 30 is not normalized to another day.
+func (d Date) AppendFormat(b []byte) []byte {
+	b = appendInt(b, d.Year, 4)
+	b = append(b, '-')
+	b = appendInt(b, int(d.Month), 2)
+	b = append(b, '-')
+	return appendInt(b, d.Day, 2)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.IsValid() {
+		return nil, ErrInvalidDate
+	}
+	return d.AppendFormat(make([]byte, 0, len(time.DateOnly))), nil
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// appendInt appends n padded with zeros to at least width digits.
+func appendInt(b []byte, n, width int) []byte {
+	u := uint(n)
+	if n < 0 {
+		b = append(b, '-')
+		u = uint(-n)
+	}
+	var buf [20]byte
+	i := len(buf)
+	for u >= 10 || width > 1 {
+		i--
+		buf[i] = byte('0' + u%10)
+		u /= 10
+		width--
+	}
+	i--
+	buf[i] = byte('0' + u)
+	return append(b, buf[i:]...)
+}
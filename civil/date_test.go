@@ -0,0 +1,76 @@
+package civil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation/civil"
+)
+
+func Test_ParseDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    civil.Date
+		wantErr bool
+	}{
+		{"date", "2024-02-29", civil.Date{Year: 2024, Month: time.February, Day: 29}, false},
+		{"not leap year", "2023-02-29", civil.Date{}, true},
+		{"time", "2024-02-29T00:00:00Z", civil.Date{}, true},
+		{"short", "2024-2-9", civil.Date{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := civil.ParseDate(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Date_IsValid(t *testing.T) {
+	tests := []struct {
+		name string
+		d    civil.Date
+		want bool
+	}{
+		{"valid", civil.Date{Year: 2024, Month: time.March, Day: 31}, true},
+		{"day out of range", civil.Date{Year: 2024, Month: time.April, Day: 31}, false},
+		{"month out of range", civil.Date{Year: 2024, Month: 13, Day: 1}, false},
+		{"zero", civil.Date{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.IsValid(); got != tt.want {
+				t.Errorf("Date.IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Date_String(t *testing.T) {
+	tests := []struct {
+		name string
+		d    civil.Date
+		want string
+	}{
+		{"date", civil.Date{Year: 2024, Month: time.February, Day: 29}, "2024-02-29"},
+		{"padding", civil.Date{Year: 33, Month: time.May, Day: 7}, "0033-05-07"},
+		{"invalid day", civil.Date{Year: 2023, Month: time.February, Day: 30}, "2023-02-30"},
+		{"invalid month", civil.Date{Year: 2024, Month: 13, Day: 1}, "2024-13-01"},
+		{"zero", civil.Date{}, "0000-00-00"},
+		{"negative year", civil.Date{Year: -44, Month: time.March, Day: 15}, "-0044-03-15"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.String(); got != tt.want {
+				t.Errorf("Date.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
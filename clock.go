@@ -0,0 +1,28 @@
+package validation
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+}
+
+type ClockFunc func() time.Time
+
+func (fn ClockFunc) Now() time.Time {
+	return fn()
+}
+
+var SystemClock Clock = ClockFunc(time.Now)
+
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time {
+		return t
+	})
+}
+
+func now(clock Clock) time.Time {
+	if clock == nil {
+		return SystemClock.Now()
+	}
+	return clock.Now()
+}
@@ -108,7 +108,7 @@ func (cv ComparableValidator[T]) Equal(v T) ComparableValidator[T] {
 
 func (cv ComparableValidator[T]) With(fns ...func(v T) error) ComparableValidator[T] {
 	if cv.scope.Ok() {
-		slices.Grow(cv.rules, len(fns))
+		cv.rules = slices.Grow(cv.rules, len(fns))
 		for _, fn := range fns {
 			cv.rules = append(cv.rules, ComparableRuleFunc[T](fn))
 		}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/infastin/go-validation/civil"
 	"github.com/infastin/go-validation/constraints"
 )
 
@@ -34,6 +35,20 @@ func EqualAny[T any](eq func(a, b T) bool, v T) compareRule[T] {
 	}
 }
 
+func EqualApprox[T constraints.Number](v, epsilon T) compareRule[T] {
+	return compareRule[T]{
+		comp: func(x T) bool {
+			if x > v {
+				return x-v <= epsilon
+			}
+			return v-x <= epsilon
+		},
+		buildError: func() error {
+			return buildEqualApproxError(v, epsilon)
+		},
+	}
+}
+
 func Less[T constraints.Ordered](v T) compareRule[T] {
 	return compareRule[T]{
 		comp: func(x T) bool {
@@ -262,10 +277,192 @@ func (r compareTimeRule) Validate(v time.Time) error {
 	return nil
 }
 
+type compareDateRule struct {
+	comp       func(x civil.Date) bool
+	buildError func() error
+}
+
+func EqualDate(v civil.Date) compareDateRule {
+	return compareDateRule{
+		comp: func(x civil.Date) bool {
+			return x == v
+		},
+		buildError: func() error {
+			return buildEqualError(v)
+		},
+	}
+}
+
+func LessDate(v civil.Date) compareDateRule {
+	return compareDateRule{
+		comp: func(x civil.Date) bool {
+			return x.Compare(v) < 0
+		},
+		buildError: func() error {
+			return buildLessError(v)
+		},
+	}
+}
+
+func LessEqualDate(v civil.Date) compareDateRule {
+	return compareDateRule{
+		comp: func(x civil.Date) bool {
+			return x.Compare(v) <= 0
+		},
+		buildError: func() error {
+			return buildLessEqualError(v)
+		},
+	}
+}
+
+func GreaterDate(v civil.Date) compareDateRule {
+	return compareDateRule{
+		comp: func(x civil.Date) bool {
+			return x.Compare(v) > 0
+		},
+		buildError: func() error {
+			return buildGreaterError(v)
+		},
+	}
+}
+
+func GreaterEqualDate(v civil.Date) compareDateRule {
+	return compareDateRule{
+		comp: func(x civil.Date) bool {
+			return x.Compare(v) >= 0
+		},
+		buildError: func() error {
+			return buildGreaterEqualError(v)
+		},
+	}
+}
+
+func BetweenDate(a, b civil.Date) compareDateRule {
+	return compareDateRule{
+		comp: func(x civil.Date) bool {
+			return x.Compare(a) > 0 && x.Compare(b) < 0
+		},
+		buildError: func() error {
+			return buildBetweenError(a, b)
+		},
+	}
+}
+
+func BetweenEqualDate(a, b civil.Date) compareDateRule {
+	return compareDateRule{
+		comp: func(x civil.Date) bool {
+			return x.Compare(a) >= 0 && x.Compare(b) <= 0
+		},
+		buildError: func() error {
+			return buildBetweenEqualError(a, b)
+		},
+	}
+}
+
+func (r compareDateRule) Validate(v civil.Date) error {
+	if !r.comp(v) {
+		return r.buildError()
+	}
+	return nil
+}
+
+type compareBigRule[T constraints.Big[T]] struct {
+	comp       func(x T) bool
+	buildError func() error
+}
+
+func EqualBig[T constraints.Big[T]](v T) compareBigRule[T] {
+	return compareBigRule[T]{
+		comp: func(x T) bool {
+			return x.Cmp(v) == 0
+		},
+		buildError: func() error {
+			return buildEqualError(formatBig(v))
+		},
+	}
+}
+
+func LessBig[T constraints.Big[T]](v T) compareBigRule[T] {
+	return compareBigRule[T]{
+		comp: func(x T) bool {
+			return x.Cmp(v) < 0
+		},
+		buildError: func() error {
+			return buildLessError(formatBig(v))
+		},
+	}
+}
+
+func LessEqualBig[T constraints.Big[T]](v T) compareBigRule[T] {
+	return compareBigRule[T]{
+		comp: func(x T) bool {
+			return x.Cmp(v) <= 0
+		},
+		buildError: func() error {
+			return buildLessEqualError(formatBig(v))
+		},
+	}
+}
+
+func GreaterBig[T constraints.Big[T]](v T) compareBigRule[T] {
+	return compareBigRule[T]{
+		comp: func(x T) bool {
+			return x.Cmp(v) > 0
+		},
+		buildError: func() error {
+			return buildGreaterError(formatBig(v))
+		},
+	}
+}
+
+func GreaterEqualBig[T constraints.Big[T]](v T) compareBigRule[T] {
+	return compareBigRule[T]{
+		comp: func(x T) bool {
+			return x.Cmp(v) >= 0
+		},
+		buildError: func() error {
+			return buildGreaterEqualError(formatBig(v))
+		},
+	}
+}
+
+func BetweenBig[T constraints.Big[T]](a, b T) compareBigRule[T] {
+	return compareBigRule[T]{
+		comp: func(x T) bool {
+			return x.Cmp(a) > 0 && x.Cmp(b) < 0
+		},
+		buildError: func() error {
+			return buildBetweenError(formatBig(a), formatBig(b))
+		},
+	}
+}
+
+func BetweenEqualBig[T constraints.Big[T]](a, b T) compareBigRule[T] {
+	return compareBigRule[T]{
+		comp: func(x T) bool {
+			return x.Cmp(a) >= 0 && x.Cmp(b) <= 0
+		},
+		buildError: func() error {
+			return buildBetweenEqualError(formatBig(a), formatBig(b))
+		},
+	}
+}
+
+func (r compareBigRule[T]) Validate(v T) error {
+	if v != nil && !r.comp(v) {
+		return r.buildError()
+	}
+	return nil
+}
+
 func buildEqualError(v any) error {
 	return NewRuleError("equal", fmt.Sprintf("must be equal to %v", v))
 }
 
+func buildEqualApproxError(v, epsilon any) error {
+	return NewRuleError("equal_approx", fmt.Sprintf("must be equal to %v within %v", v, epsilon))
+}
+
 func buildLessError(v any) error {
 	return NewRuleError("less", fmt.Sprintf("must be less than %v", v))
 }
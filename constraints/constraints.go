@@ -1,5 +1,7 @@
 package constraints
 
+import "math/big"
+
 type Int interface {
 	~int8 | ~int16 | ~int32 | ~int64 | ~int
 }
@@ -23,3 +25,9 @@ type Ordered interface {
 type UUID interface {
 	~[16]byte
 }
+
+type Big[T any] interface {
+	*big.Int | *big.Float | *big.Rat
+	Cmp(y T) int
+	Sign() int
+}
@@ -0,0 +1,140 @@
+package validation
+
+import "fmt"
+
+type containsSliceRule[T any] struct {
+	values     []T
+	eq         func(a, b T) bool
+	buildError func(v T) error
+}
+
+func ContainsSlice[T comparable](v T) containsSliceRule[T] {
+	return ContainsAllSlice(v)
+}
+
+func ContainsSliceAny[T any](eq func(a, b T) bool, v T) containsSliceRule[T] {
+	return ContainsAllSliceAny(eq, v)
+}
+
+func ContainsAllSlice[T comparable](values ...T) containsSliceRule[T] {
+	return ContainsAllSliceAny(isEqual[T], values...)
+}
+
+func ContainsAllSliceAny[T any](eq func(a, b T) bool, values ...T) containsSliceRule[T] {
+	return containsSliceRule[T]{
+		values: values,
+		eq:     eq,
+		buildError: func(v T) error {
+			return buildContainsError(v)
+		},
+	}
+}
+
+func (r containsSliceRule[T]) Validate(s []T) error {
+	for _, v := range r.values {
+		if indexOf(s, v, r.eq) == -1 {
+			return r.buildError(v)
+		}
+	}
+	return nil
+}
+
+type containsAnySliceRule[T any] struct {
+	values     []T
+	eq         func(a, b T) bool
+	buildError func() error
+}
+
+func ContainsAnySlice[T comparable](values ...T) containsAnySliceRule[T] {
+	return ContainsAnySliceAny(isEqual[T], values...)
+}
+
+func ContainsAnySliceAny[T any](eq func(a, b T) bool, values ...T) containsAnySliceRule[T] {
+	return containsAnySliceRule[T]{
+		values: values,
+		eq:     eq,
+		buildError: func() error {
+			return buildContainsAnyError(values)
+		},
+	}
+}
+
+func (r containsAnySliceRule[T]) Validate(s []T) error {
+	for _, v := range r.values {
+		if indexOf(s, v, r.eq) != -1 {
+			return nil
+		}
+	}
+	return r.buildError()
+}
+
+type subsetSliceRule[T any] struct {
+	elements []T
+	eq       func(a, b T) bool
+}
+
+func SubsetOfSlice[T comparable](allowed ...T) subsetSliceRule[T] {
+	return SubsetOfSliceAny(isEqual[T], allowed...)
+}
+
+func SubsetOfSliceAny[T any](eq func(a, b T) bool, allowed ...T) subsetSliceRule[T] {
+	return subsetSliceRule[T]{
+		elements: allowed,
+		eq:       eq,
+	}
+}
+
+func (r subsetSliceRule[T]) Validate(s []T) error {
+	for i := range s {
+		if indexOf(r.elements, s[i], r.eq) == -1 {
+			return NewIndexError(i, ErrInInvalid)
+		}
+	}
+	return nil
+}
+
+type disjointSliceRule[T any] struct {
+	elements []T
+	eq       func(a, b T) bool
+}
+
+func DisjointSlice[T comparable](forbidden ...T) disjointSliceRule[T] {
+	return DisjointSliceAny(isEqual[T], forbidden...)
+}
+
+func DisjointSliceAny[T any](eq func(a, b T) bool, forbidden ...T) disjointSliceRule[T] {
+	return disjointSliceRule[T]{
+		elements: forbidden,
+		eq:       eq,
+	}
+}
+
+func (r disjointSliceRule[T]) Validate(s []T) error {
+	for i := range s {
+		if indexOf(r.elements, s[i], r.eq) != -1 {
+			return NewIndexError(i, ErrNotInInvalid)
+		}
+	}
+	return nil
+}
+
+func isEqual[T comparable](a, b T) bool {
+	return a == b
+}
+
+func indexOf[T any](s []T, v T, eq func(a, b T) bool) int {
+	for i := range s {
+		if eq(s[i], v) {
+			return i
+		}
+	}
+	return -1
+}
+
+func buildContainsError(v any) error {
+	return NewRuleError("contains", fmt.Sprintf("must contain %v", v))
+}
+
+func buildContainsAnyError(values any) error {
+	return NewRuleError("contains_any", fmt.Sprintf("must contain at least one of %v", values))
+}
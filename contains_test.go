@@ -0,0 +1,134 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_ContainsAllSlice_Validate(t *testing.T) {
+	type params struct {
+		values []string
+		slice  []string
+	}
+	tests := []struct {
+		name    string
+		params  params
+		want    string
+		wantErr bool
+	}{
+		{"contains", params{[]string{"read"}, []string{"read", "write"}}, "", false},
+		{"contains all", params{[]string{"read", "write"}, []string{"write", "read"}}, "", false},
+		{"missing", params{[]string{"read", "admin"}, []string{"read", "write"}}, "must contain admin", true},
+		{"nil", params{[]string{"read"}, nil}, "must contain read", true},
+		{"no values", params{nil, nil}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validation.ContainsAllSlice(tt.params.values...)
+			got := r.Validate(tt.params.slice)
+			if (got != nil) != tt.wantErr {
+				t.Errorf("ContainsAllSlice.Validate() error = %v, wantErr %v", got, tt.wantErr)
+				return
+			}
+			if got != nil && got.Error() != tt.want {
+				t.Errorf("ContainsAllSlice.Validate() = %v, want %v", got.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_ContainsAnySlice_Validate(t *testing.T) {
+	type params struct {
+		values []string
+		slice  []string
+	}
+	tests := []struct {
+		name    string
+		params  params
+		want    string
+		wantErr bool
+	}{
+		{"contains one", params{[]string{"admin", "write"}, []string{"read", "write"}}, "", false},
+		{"contains none", params{[]string{"admin", "owner"}, []string{"read"}}, "must contain at least one of [admin owner]", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validation.ContainsAnySlice(tt.params.values...)
+			got := r.Validate(tt.params.slice)
+			if (got != nil) != tt.wantErr {
+				t.Errorf("ContainsAnySlice.Validate() error = %v, wantErr %v", got, tt.wantErr)
+				return
+			}
+			if got != nil && got.Error() != tt.want {
+				t.Errorf("ContainsAnySlice.Validate() = %v, want %v", got.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_SubsetOfSlice_Validate(t *testing.T) {
+	type params struct {
+		allowed []string
+		slice   []string
+	}
+	tests := []struct {
+		name   string
+		params params
+		index  int
+		want   error
+	}{
+		{"subset", params{[]string{"read", "write"}, []string{"write"}}, 0, nil},
+		{"empty", params{[]string{"read", "write"}, nil}, 0, nil},
+		{"not subset", params{[]string{"read", "write"}, []string{"read", "admin"}}, 1, validation.ErrInInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validation.SubsetOfSlice(tt.params.allowed...)
+			got := r.Validate(tt.params.slice)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("SubsetOfSlice.Validate() = %v, want nil", got)
+				}
+				return
+			}
+			var ie validation.IndexError
+			if !errors.As(got, &ie) || ie.Index() != tt.index || ie.Unwrap() != tt.want {
+				t.Errorf("SubsetOfSlice.Validate() = %v, want [%d]: %v", got, tt.index, tt.want)
+			}
+		})
+	}
+}
+
+func Test_DisjointSlice_Validate(t *testing.T) {
+	type params struct {
+		forbidden []string
+		slice     []string
+	}
+	tests := []struct {
+		name   string
+		params params
+		index  int
+		want   error
+	}{
+		{"disjoint", params{[]string{"root"}, []string{"read", "write"}}, 0, nil},
+		{"not disjoint", params{[]string{"root"}, []string{"read", "root"}}, 1, validation.ErrNotInInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validation.DisjointSlice(tt.params.forbidden...)
+			got := r.Validate(tt.params.slice)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("DisjointSlice.Validate() = %v, want nil", got)
+				}
+				return
+			}
+			var ie validation.IndexError
+			if !errors.As(got, &ie) || ie.Index() != tt.index || ie.Unwrap() != tt.want {
+				t.Errorf("DisjointSlice.Validate() = %v, want [%d]: %v", got, tt.index, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package validation
+
+import (
+	"slices"
+	"time"
+
+	"github.com/infastin/go-validation/civil"
+)
+
+var ErrDateInvalid = NewRuleError("date_invalid", "must be a valid date")
+
+type validDateRule struct{}
+
+// ValidDate checks that a civil.Date denotes an existing day,
+// rejecting struct literals such as February 30 or month 13.
+// The zero date is left to Required.
+func ValidDate() validDateRule {
+	return validDateRule{}
+}
+
+func (r validDateRule) Validate(d civil.Date) error {
+	if !d.IsZero() && !d.IsValid() {
+		return ErrDateInvalid
+	}
+	return nil
+}
+
+type dateValidatorData struct {
+	value civil.Date
+	name  string
+}
+
+type DateValidator struct {
+	data  *dateValidatorData
+	rules []DateRule
+	scope validatorScope
+}
+
+func Date(d civil.Date, name string) DateValidator {
+	return DateValidator{
+		data: &dateValidatorData{
+			value: d,
+			name:  name,
+		},
+		rules: make([]DateRule, 0),
+		scope: nil,
+	}
+}
+
+func DateI(d civil.Date) DateValidator {
+	return DateValidator{
+		data: &dateValidatorData{
+			value: d,
+			name:  "",
+		},
+		rules: make([]DateRule, 0),
+		scope: nil,
+	}
+}
+
+func DateV() DateValidator {
+	return DateValidator{
+		data:  nil,
+		rules: make([]DateRule, 0),
+		scope: nil,
+	}
+}
+
+func (dv DateValidator) If(condition bool) DateValidator {
+	if dv.scope.Ok() {
+		dv.scope = dv.scope.Push(condition)
+	}
+	return dv
+}
+
+func (dv DateValidator) ElseIf(condition bool) DateValidator {
+	if !dv.scope.Ok() {
+		dv.scope.Set(condition)
+	}
+	return dv
+}
+
+func (dv DateValidator) Else() DateValidator {
+	if !dv.scope.Ok() {
+		dv.scope.Set(true)
+	}
+	return dv
+}
+
+func (dv DateValidator) Break(condition bool) DateValidator {
+	if !dv.scope.Empty() && condition {
+		dv.scope.Set(false)
+	}
+	return dv
+}
+
+func (dv DateValidator) EndIf() DateValidator {
+	if !dv.scope.Empty() {
+		dv.scope = dv.scope.Pop()
+	}
+	return dv
+}
+
+func (dv DateValidator) Required(condition bool) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, RequiredDate(condition))
+	}
+	return dv
+}
+
+func (dv DateValidator) ValidDate() DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, ValidDate())
+	}
+	return dv
+}
+
+func (dv DateValidator) In(elements ...civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, InDate(elements...))
+	}
+	return dv
+}
+
+func (dv DateValidator) NotIn(elements ...civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, NotInDate(elements...))
+	}
+	return dv
+}
+
+func (dv DateValidator) Equal(v civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, EqualDate(v))
+	}
+	return dv
+}
+
+func (dv DateValidator) Less(v civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, LessDate(v))
+	}
+	return dv
+}
+
+func (dv DateValidator) LessEqual(v civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, LessEqualDate(v))
+	}
+	return dv
+}
+
+func (dv DateValidator) Greater(v civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, GreaterDate(v))
+	}
+	return dv
+}
+
+func (dv DateValidator) GreaterEqual(v civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, GreaterEqualDate(v))
+	}
+	return dv
+}
+
+func (dv DateValidator) Between(a, b civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, BetweenDate(a, b))
+	}
+	return dv
+}
+
+func (dv DateValidator) BetweenEqual(a, b civil.Date) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, BetweenEqualDate(a, b))
+	}
+	return dv
+}
+
+func (dv DateValidator) AsTime(loc *time.Location, rules ...TimeRule) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, AsTimeDate(loc, rules...))
+	}
+	return dv
+}
+
+func (dv DateValidator) With(fns ...func(d civil.Date) error) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = slices.Grow(dv.rules, len(fns))
+		for _, fn := range fns {
+			dv.rules = append(dv.rules, DateRuleFunc(fn))
+		}
+	}
+	return dv
+}
+
+func (dv DateValidator) By(rules ...DateRule) DateValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, rules...)
+	}
+	return dv
+}
+
+func (dv DateValidator) Valid() error {
+	for _, rule := range dv.rules {
+		if err := rule.Validate(dv.data.value); err != nil {
+			if dv.data.name != "" {
+				err = NewValueError(dv.data.name, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (dv DateValidator) Validate(d civil.Date) error {
+	for _, rule := range dv.rules {
+		if err := rule.Validate(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation"
+	"github.com/infastin/go-validation/civil"
+)
+
+func Test_DateValidator_Valid(t *testing.T) {
+	var (
+		birthday = civil.Date{Year: 2000, Month: time.February, Day: 29}
+		holiday  = civil.Date{Year: 2024, Month: time.December, Day: 25}
+		clock    = validation.FixedClock(time.Date(2018, 2, 28, 12, 0, 0, 0, time.UTC))
+	)
+	tests := []struct {
+		name      string
+		validator validation.Validator
+		want      string
+	}{
+		{"required", validation.Date(civil.Date{}, "date").Required(true), "date: cannot be blank"},
+		{"between", validation.Date(birthday, "birthday").BetweenEqual(civil.Date{Year: 1900, Month: 1, Day: 1}, holiday), ""},
+		{"less", validation.Date(holiday, "holiday").Less(birthday), "holiday: must be less than 2000-02-29"},
+		{"in", validation.Date(holiday, "holiday").In(birthday), "holiday: must be a valid value"},
+		{"valid date", validation.Date(birthday, "birthday").ValidDate().Less(holiday), ""},
+		{"invalid day", validation.Date(civil.Date{Year: 2023, Month: time.February, Day: 29}, "date").ValidDate().Less(holiday), "date: must be a valid date"},
+		{"invalid month", validation.Date(civil.Date{Year: 2024, Month: 13, Day: 1}, "date").ValidDate(), "date: must be a valid date"},
+		{"zero date", validation.Date(civil.Date{}, "date").ValidDate(), ""},
+		{"as time", validation.Date(birthday, "birthday").AsTime(nil, validation.TimeV().Clock(clock).MinAge(18)), "birthday: the age must be no less than 18 years"},
+		{"as date", validation.Time(time.Date(2024, 12, 24, 23, 30, 0, 0, time.UTC), "at").AsDate(time.FixedZone("UTC+1", 3600), validation.DateV().Equal(holiday)), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.validator.Valid(); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("DateValidator.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"time"
+
+	"github.com/infastin/go-validation/civil"
+)
+
+type asDateTimeRule struct {
+	loc   *time.Location
+	rules []DateRule
+}
+
+func AsDateTime(loc *time.Location, rules ...DateRule) asDateTimeRule {
+	return asDateTimeRule{
+		loc:   loc,
+		rules: rules,
+	}
+}
+
+func (r asDateTimeRule) Validate(t time.Time) error {
+	if r.loc != nil {
+		t = t.In(r.loc)
+	}
+	d := civil.DateOf(t)
+	for _, rule := range r.rules {
+		if err := rule.Validate(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type asTimeDateRule struct {
+	loc   *time.Location
+	rules []TimeRule
+}
+
+func AsTimeDate(loc *time.Location, rules ...TimeRule) asTimeDateRule {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return asTimeDateRule{
+		loc:   loc,
+		rules: rules,
+	}
+}
+
+func (r asTimeDateRule) Validate(d civil.Date) error {
+	t := d.In(r.loc)
+	for _, rule := range r.rules {
+		if err := rule.Validate(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package validation
+
+import (
+	"slices"
+	"time"
+)
+
+type durationValidatorData struct {
+	value time.Duration
+	name  string
+}
+
+type DurationValidator struct {
+	data  *durationValidatorData
+	rules []DurationRule
+	scope validatorScope
+}
+
+func Duration(d time.Duration, name string) DurationValidator {
+	return DurationValidator{
+		data: &durationValidatorData{
+			value: d,
+			name:  name,
+		},
+		rules: make([]DurationRule, 0),
+		scope: nil,
+	}
+}
+
+func DurationI(d time.Duration) DurationValidator {
+	return DurationValidator{
+		data: &durationValidatorData{
+			value: d,
+			name:  "",
+		},
+		rules: make([]DurationRule, 0),
+		scope: nil,
+	}
+}
+
+func DurationV() DurationValidator {
+	return DurationValidator{
+		data:  nil,
+		rules: make([]DurationRule, 0),
+		scope: nil,
+	}
+}
+
+func (dv DurationValidator) If(condition bool) DurationValidator {
+	if dv.scope.Ok() {
+		dv.scope = dv.scope.Push(condition)
+	}
+	return dv
+}
+
+func (dv DurationValidator) ElseIf(condition bool) DurationValidator {
+	if !dv.scope.Ok() {
+		dv.scope.Set(condition)
+	}
+	return dv
+}
+
+func (dv DurationValidator) Else() DurationValidator {
+	if !dv.scope.Ok() {
+		dv.scope.Set(true)
+	}
+	return dv
+}
+
+func (dv DurationValidator) Break(condition bool) DurationValidator {
+	if !dv.scope.Empty() && condition {
+		dv.scope.Set(false)
+	}
+	return dv
+}
+
+func (dv DurationValidator) EndIf() DurationValidator {
+	if !dv.scope.Empty() {
+		dv.scope = dv.scope.Pop()
+	}
+	return dv
+}
+
+func (dv DurationValidator) Required(condition bool) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, Required[time.Duration](condition))
+	}
+	return dv
+}
+
+func (dv DurationValidator) In(elements ...time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, In(elements...))
+	}
+	return dv
+}
+
+func (dv DurationValidator) NotIn(elements ...time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, NotIn(elements...))
+	}
+	return dv
+}
+
+func (dv DurationValidator) Equal(v time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, Equal(v))
+	}
+	return dv
+}
+
+func (dv DurationValidator) Less(v time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, Less(v))
+	}
+	return dv
+}
+
+func (dv DurationValidator) LessEqual(v time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, LessEqual(v))
+	}
+	return dv
+}
+
+func (dv DurationValidator) Greater(v time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, Greater(v))
+	}
+	return dv
+}
+
+func (dv DurationValidator) GreaterEqual(v time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, GreaterEqual(v))
+	}
+	return dv
+}
+
+func (dv DurationValidator) Between(a, b time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, Between(a, b))
+	}
+	return dv
+}
+
+func (dv DurationValidator) BetweenEqual(a, b time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, BetweenEqual(a, b))
+	}
+	return dv
+}
+
+func (dv DurationValidator) Min(v time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, GreaterEqual(v))
+	}
+	return dv
+}
+
+func (dv DurationValidator) Max(v time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, LessEqual(v))
+	}
+	return dv
+}
+
+func (dv DurationValidator) MultipleOf(step time.Duration) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, MultipleOf(step))
+	}
+	return dv
+}
+
+func (dv DurationValidator) Positive() DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, Positive[time.Duration]())
+	}
+	return dv
+}
+
+func (dv DurationValidator) NonNegative() DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, NonNegative[time.Duration]())
+	}
+	return dv
+}
+
+func (dv DurationValidator) With(fns ...func(d time.Duration) error) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = slices.Grow(dv.rules, len(fns))
+		for _, fn := range fns {
+			dv.rules = append(dv.rules, DurationRuleFunc(fn))
+		}
+	}
+	return dv
+}
+
+func (dv DurationValidator) By(rules ...DurationRule) DurationValidator {
+	if dv.scope.Ok() {
+		dv.rules = append(dv.rules, rules...)
+	}
+	return dv
+}
+
+func (dv DurationValidator) Valid() error {
+	for _, rule := range dv.rules {
+		if err := rule.Validate(dv.data.value); err != nil {
+			if dv.data.name != "" {
+				err = NewValueError(dv.data.name, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (dv DurationValidator) Validate(d time.Duration) error {
+	for _, rule := range dv.rules {
+		if err := rule.Validate(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_DurationValidator_Valid(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validation.Validator
+		want      string
+	}{
+		{"between", validation.Duration(5*time.Second, "timeout").Between(time.Second, 30*time.Second), ""},
+		{"out of range", validation.Duration(0, "timeout").Between(time.Second, 30*time.Second), "timeout: must exclusively be between 1s and 30s"},
+		{"min", validation.Duration(500*time.Millisecond, "timeout").Min(time.Second), "timeout: must be no less than 1s"},
+		{"max", validation.Duration(time.Hour, "timeout").Max(30 * time.Minute), "timeout: must be no greater than 30m0s"},
+		{"multiple of", validation.Duration(1500*time.Millisecond, "interval").MultipleOf(time.Second), "interval: must be a multiple of 1s"},
+		{"non negative", validation.Duration(-time.Second, "delay").NonNegative(), "delay: must not be negative"},
+		{"string", validation.String("90s", "ttl").By(isstr.DurationBy[string](validation.DurationV().Max(time.Minute))), "ttl: must be no greater than 1m0s"},
+		{"invalid string", validation.String("soon", "ttl").With(isstr.Duration), "ttl: must be a valid duration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.validator.Valid(); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("DurationValidator.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package validation
 
 import (
+	"encoding/json"
+	"maps"
 	"strconv"
 	"strings"
 )
@@ -11,15 +13,32 @@ type RuleError interface {
 	Message() string
 }
 
+// ParamsError is implemented by rule errors that carry parameters,
+// such as the pattern a value had to match.
+type ParamsError interface {
+	RuleError
+	Params() map[string]any
+}
+
 type ruleError struct {
 	code    string
 	message string
+	params  map[string]any
 }
 
 func NewRuleError(code, message string) RuleError {
 	return &ruleError{
 		code:    code,
 		message: message,
+		params:  nil,
+	}
+}
+
+func NewRuleErrorParams(code, message string, params map[string]any) ParamsError {
+	return &ruleError{
+		code:    code,
+		message: message,
+		params:  params,
 	}
 }
 
@@ -35,6 +54,11 @@ func (re *ruleError) Message() string {
 	return re.message
 }
 
+// Params returns a copy of the parameters, the error itself may be shared.
+func (re *ruleError) Params() map[string]any {
+	return maps.Clone(re.params)
+}
+
 type ValueError interface {
 	Error() string
 	Unwrap() error
@@ -160,46 +184,79 @@ func (es Errors) Error() string {
 	return b.String()
 }
 
-func errorMarshalJSON(err error, b []byte) []byte {
+func errorMarshalJSON(err error, b []byte) ([]byte, error) {
 	switch e := err.(type) {
 	case Errors:
 		b = append(b, '{')
-		b = e.marshalJSON(b)
+		b, err = e.marshalJSON(b)
+		if err != nil {
+			return nil, err
+		}
 		b = append(b, '}')
 	case IndexError:
 		b = append(b, '{', '"')
 		b = strconv.AppendInt(b, int64(e.Index()), 10)
 		b = append(b, '"', ':')
-		b = errorMarshalJSON(e.Unwrap(), b)
+		b, err = errorMarshalJSON(e.Unwrap(), b)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, '}')
+	case ParamsError:
+		params := e.Params()
+		if len(params) == 0 {
+			b = strconv.AppendQuote(b, e.Error())
+			break
+		}
+		data, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, `{"code":`...)
+		b = strconv.AppendQuote(b, e.Code())
+		b = append(b, `,"message":`...)
+		b = strconv.AppendQuote(b, e.Message())
+		b = append(b, `,"params":`...)
+		b = append(b, data...)
 		b = append(b, '}')
 	default:
 		b = strconv.AppendQuote(b, e.Error())
 	}
-	return b
+	return b, nil
 }
 
-func (es Errors) marshalJSON(b []byte) []byte {
+func (es Errors) marshalJSON(b []byte) ([]byte, error) {
 	sz := len(b)
 	for _, err := range es {
+		var merr error
 		switch e := err.(type) {
 		case Errors:
-			b = e.marshalJSON(b)
+			b, merr = e.marshalJSON(b)
 		case ValueError:
 			if sz != len(b) {
 				b = append(b, ',')
 			}
 			b = strconv.AppendQuote(b, e.Name())
 			b = append(b, ':')
-			b = errorMarshalJSON(e.Unwrap(), b)
+			b, merr = errorMarshalJSON(e.Unwrap(), b)
+		}
+		if merr != nil {
+			return nil, merr
 		}
 	}
-	return b
+	return b, nil
 }
 
+// MarshalJSON encodes the errors as an object keyed by value names.
+// Rule errors that carry parameters are encoded as objects with
+// the code, message and params fields, other errors as their messages.
 func (es Errors) MarshalJSON() ([]byte, error) {
 	var b []byte
 	b = append(b, '{')
-	b = es.marshalJSON(b)
+	b, err := es.marshalJSON(b)
+	if err != nil {
+		return nil, err
+	}
 	b = append(b, '}')
 	return b, nil
 }
@@ -80,6 +80,36 @@ func Test_ruleError_Message(t *testing.T) {
 	}
 }
 
+func Test_ruleError_Params(t *testing.T) {
+	type fields struct {
+		code    string
+		message string
+		params  map[string]any
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   map[string]any
+	}{
+		{"nil", fields{"a", "b", nil}, nil},
+		{"pattern", fields{"match", "must match", map[string]any{"pattern": "^a$"}}, map[string]any{"pattern": "^a$"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re := validation.NewRuleErrorParams(tt.fields.code, tt.fields.message, tt.fields.params)
+			if got := re.Params(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ruleError.Params() = %v, want %v", got, tt.want)
+			}
+			if got := re.Params(); got != nil {
+				got["pattern"] = "changed"
+				if got := re.Params(); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ruleError.Params() = %v after modification, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
 func Test_valueError_Error(t *testing.T) {
 	type fields struct {
 		name   string
@@ -218,6 +248,13 @@ func TestErrors_MarshalJSON(t *testing.T) {
 			validation.NewValueError("baz", errors.New("quux")),
 			errors.New("A"),
 		}, []byte(`{"foo":{"0":"bar"},"baz":"quux"}`), false},
+		{"params", []error{
+			validation.NewValueError("pin", validation.NewRuleErrorParams("pin", "must be a 4-digit PIN", map[string]any{"pattern": `^\d{4}$`})),
+			validation.NewValueError("name", validation.NewRuleErrorParams("required", "cannot be blank", nil)),
+		}, []byte(`{"pin":{"code":"pin","message":"must be a 4-digit PIN","params":{"pattern":"^\\d{4}$"}},"name":"cannot be blank"}`), false},
+		{"unsupported params", []error{
+			validation.NewValueError("foo", validation.NewRuleErrorParams("foo", "bar", map[string]any{"fn": func() {}})),
+		}, nil, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
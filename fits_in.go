@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"unsafe"
+
+	"github.com/infastin/go-validation/constraints"
+)
+
+func FitsIn[R, T constraints.Number](n T) error {
+	var (
+		r    R
+		bits = int(unsafe.Sizeof(r)) * 8
+	)
+
+	if isFloat[R]() {
+		if f := float64(n); bits == 32 && !math.IsInf(f, 0) && math.Abs(f) > math.MaxFloat32 {
+			return buildFitsInError(r, strconv.FormatFloat(-math.MaxFloat32, 'g', -1, 32),
+				strconv.FormatFloat(math.MaxFloat32, 'g', -1, 32))
+		}
+		return nil
+	}
+
+	var (
+		ok     bool
+		lo, hi string
+	)
+
+	if isSigned[R]() {
+		lo = strconv.FormatInt(math.MinInt64>>(64-bits), 10)
+		hi = strconv.FormatInt(math.MaxInt64>>(64-bits), 10)
+	} else {
+		lo = "0"
+		hi = strconv.FormatUint(math.MaxUint64>>(64-bits), 10)
+	}
+
+	switch {
+	case isFloat[T]():
+		f := float64(n)
+		if f != math.Trunc(f) && !math.IsInf(f, 0) {
+			return buildFitsInIntegerError(r)
+		}
+		if isSigned[R]() {
+			ok = f >= -math.Ldexp(1, bits-1) && f < math.Ldexp(1, bits-1)
+		} else {
+			ok = f >= 0 && f < math.Ldexp(1, bits)
+		}
+	case isSigned[T]():
+		i := int64(n)
+		if isSigned[R]() {
+			ok = i >= math.MinInt64>>(64-bits) && i <= math.MaxInt64>>(64-bits)
+		} else {
+			ok = i >= 0 && uint64(i) <= math.MaxUint64>>(64-bits)
+		}
+	default:
+		u := uint64(n)
+		if isSigned[R]() {
+			ok = u <= math.MaxInt64>>(64-bits)
+		} else {
+			ok = u <= math.MaxUint64>>(64-bits)
+		}
+	}
+
+	if !ok {
+		return buildFitsInError(r, lo, hi)
+	}
+
+	return nil
+}
+
+func buildFitsInError(r any, lo, hi string) error {
+	return NewRuleError("fits_in", fmt.Sprintf("must be between %s and %s to fit in %T", lo, hi, r))
+}
+
+func buildFitsInIntegerError(r any) error {
+	return NewRuleError("fits_in_integer", fmt.Sprintf("must be an integer to fit in %T", r))
+}
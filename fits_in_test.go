@@ -0,0 +1,41 @@
+package validation_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_FitsIn(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"int64 in int32", validation.FitsIn[int32](int64(math.MaxInt32)), ""},
+		{"int64 above int32", validation.FitsIn[int32](int64(math.MaxInt32 + 1)), "must be between -2147483648 and 2147483647 to fit in int32"},
+		{"int64 below int32", validation.FitsIn[int32](int64(math.MinInt32 - 1)), "must be between -2147483648 and 2147483647 to fit in int32"},
+		{"negative in uint16", validation.FitsIn[uint16](-1), "must be between 0 and 65535 to fit in uint16"},
+		{"int in uint16", validation.FitsIn[uint16](65535), ""},
+		{"uint64 in int64", validation.FitsIn[int64](uint64(math.MaxUint64)), "must be between -9223372036854775808 and 9223372036854775807 to fit in int64"},
+		{"uint64 in uint64", validation.FitsIn[uint64](uint64(math.MaxUint64)), ""},
+		{"float in int8", validation.FitsIn[int8](127.0), ""},
+		{"float above int8", validation.FitsIn[int8](128.0), "must be between -128 and 127 to fit in int8"},
+		{"fraction in int8", validation.FitsIn[int8](1.5), "must be an integer to fit in int8"},
+		{"float in int64", validation.FitsIn[int64](math.Ldexp(1, 63)), "must be between -9223372036854775808 and 9223372036854775807 to fit in int64"},
+		{"float in float32", validation.FitsIn[float32](1e300), "must be between -3.4028235e+38 and 3.4028235e+38 to fit in float32"},
+		{"int in float32", validation.FitsIn[float32](math.MaxInt64), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if tt.err != nil {
+				got = tt.err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("FitsIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
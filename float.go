@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"github.com/infastin/go-validation/constraints"
+)
+
+var ErrFinite = NewRuleError("finite", "must be a finite number")
+
+type finiteRule[T constraints.Number] struct{}
+
+func Finite[T constraints.Number]() finiteRule[T] {
+	return finiteRule[T]{}
+}
+
+func (r finiteRule[T]) Validate(n T) error {
+	if f := float64(n); math.IsNaN(f) || math.IsInf(f, 0) {
+		return ErrFinite
+	}
+	return nil
+}
+
+type decimalPlacesRule[T constraints.Number] struct {
+	max        int
+	buildError func() error
+}
+
+func MaxDecimalPlaces[T constraints.Number](max int) decimalPlacesRule[T] {
+	return decimalPlacesRule[T]{
+		max: max,
+		buildError: func() error {
+			return buildDecimalPlacesError(max)
+		},
+	}
+}
+
+func (r decimalPlacesRule[T]) Validate(n T) error {
+	if !isFloat[T]() {
+		return nil
+	}
+
+	bitSize := 64
+	if unsafe.Sizeof(n) == 4 {
+		bitSize = 32
+	}
+
+	s := strconv.FormatFloat(float64(n), 'f', -1, bitSize)
+	if i := strings.IndexByte(s, '.'); i != -1 && len(s)-i-1 > r.max {
+		return r.buildError()
+	}
+
+	return nil
+}
+
+func buildDecimalPlacesError(max int) error {
+	return NewRuleError("too_many_decimal_places", "must have no more than "+strconv.Itoa(max)+" decimal places")
+}
@@ -1,6 +1,11 @@
 package validation
 
-import "time"
+import (
+	"time"
+
+	"github.com/infastin/go-validation/civil"
+	"github.com/infastin/go-validation/constraints"
+)
 
 var ErrInInvalid = NewRuleError("in_invalid", "must be a valid value")
 
@@ -62,3 +67,44 @@ func (r inTimeRule) Validate(t time.Time) error {
 	}
 	return ErrInInvalid
 }
+
+type inBigRule[T constraints.Big[T]] struct {
+	elements []T
+}
+
+func InBig[T constraints.Big[T]](elements ...T) inBigRule[T] {
+	return inBigRule[T]{
+		elements: elements,
+	}
+}
+
+func (r inBigRule[T]) Validate(v T) error {
+	if v == nil {
+		return nil
+	}
+	for i := range r.elements {
+		if v.Cmp(r.elements[i]) == 0 {
+			return nil
+		}
+	}
+	return ErrInInvalid
+}
+
+type inDateRule struct {
+	elements []civil.Date
+}
+
+func InDate(elements ...civil.Date) inDateRule {
+	return inDateRule{
+		elements: elements,
+	}
+}
+
+func (r inDateRule) Validate(d civil.Date) error {
+	for i := range r.elements {
+		if d == r.elements[i] {
+			return nil
+		}
+	}
+	return ErrInInvalid
+}
@@ -10,7 +10,7 @@ var (
 )
 
 func Port[T constraints.Int](i T) error {
-	if i <= 0 || int32(i) >= 65536 {
+	if i <= 0 || uint64(i) >= 65536 {
 		return ErrPort
 	}
 	return nil
@@ -0,0 +1,301 @@
+package isstr
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/infastin/go-validation"
+)
+
+var ErrCron = validation.NewRuleError("is_cron", "must be a valid cron expression")
+
+func Cron[T ~string](v T) error {
+	if _, ok := parseCron(string(v)); !ok {
+		return ErrCron
+	}
+	return nil
+}
+
+// CronMinInterval checks that a cron expression does not fire more often than every d.
+// The expression is parsed on every call, and schedules that fire at most once a day
+// walk the months of a 400-year cycle, which takes tens of microseconds.
+func CronMinInterval[T ~string](d time.Duration) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		c, ok := parseCron(string(v))
+		if !ok {
+			return ErrCron
+		}
+		if c.minInterval() < d {
+			return buildMinIntervalError("cron_min_interval", d)
+		}
+		return nil
+	}
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	cronDayNames   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+type cronSchedule struct {
+	second, minute, hour uint64
+	dom, month, dow      uint64
+	domStar, dowStar     bool
+}
+
+func parseCron(s string) (c cronSchedule, ok bool) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "@") {
+		if s, ok = cronMacros[strings.ToLower(s)]; !ok {
+			return c, false
+		}
+	}
+
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 5:
+		c.second = 1
+	case 6:
+		if c.second, _, ok = parseCronField(fields[0], 0, 59, nil); !ok {
+			return c, false
+		}
+		fields = fields[1:]
+	default:
+		return c, false
+	}
+
+	if c.minute, _, ok = parseCronField(fields[0], 0, 59, nil); !ok {
+		return c, false
+	}
+	if c.hour, _, ok = parseCronField(fields[1], 0, 23, nil); !ok {
+		return c, false
+	}
+	if c.dom, c.domStar, ok = parseCronField(fields[2], 1, 31, nil); !ok {
+		return c, false
+	}
+	if c.month, _, ok = parseCronField(fields[3], 1, 12, cronMonthNames); !ok {
+		return c, false
+	}
+	if c.dow, c.dowStar, ok = parseCronField(fields[4], 0, 7, cronDayNames); !ok {
+		return c, false
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+
+	// With an explicit day of month and any day of week allowed,
+	// at least one of the listed days has to exist in one of the listed months.
+	if !c.domStar && c.dowStar {
+		for m := 1; m <= 12; m++ {
+			if c.month&(1<<m) != 0 && c.dom&(1<<(daysInMonth(2000, time.Month(m))+1)-1) != 0 {
+				return c, true
+			}
+		}
+		return c, false
+	}
+
+	return c, true
+}
+
+func parseCronField(s string, lo, hi int, names []string) (set uint64, star, ok bool) {
+	for _, part := range strings.Split(s, ",") {
+		rng, step, hasStep := strings.Cut(part, "/")
+
+		var from, to int
+		switch {
+		case rng == "*":
+			from, to = lo, hi
+			star = !hasStep
+		case strings.Contains(rng, "-"):
+			a, b, _ := strings.Cut(rng, "-")
+			if from, ok = parseCronValue(a, lo, hi, names); !ok {
+				return 0, false, false
+			}
+			if to, ok = parseCronValue(b, lo, hi, names); !ok || to < from {
+				return 0, false, false
+			}
+		default:
+			if from, ok = parseCronValue(rng, lo, hi, names); !ok {
+				return 0, false, false
+			}
+			to = from
+			if hasStep {
+				to = hi
+			}
+		}
+
+		inc := 1
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n <= 0 || !isDigit(step[0]) {
+				return 0, false, false
+			}
+			inc = n
+		}
+
+		for i := from; i <= to; i += inc {
+			set |= 1 << i
+		}
+	}
+	return set, star, true
+}
+
+func parseCronValue(s string, lo, hi int, names []string) (int, bool) {
+	for i, name := range names {
+		if strings.EqualFold(s, name) {
+			return i + lo, true
+		}
+	}
+	if s == "" || !isDigit(s[0]) {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < lo || n > hi {
+		return 0, false
+	}
+	return n, true
+}
+
+// minInterval returns the shortest time between two consecutive firings.
+// The cost does not depend on how often the schedule fires: the gaps within
+// a day are derived from the field bitsets, and the gaps between days from
+// the firing days of each month of a 400-year cycle.
+func (c *cronSchedule) minInterval() time.Duration {
+	const day = 24 * 60 * 60
+
+	hFirst, hLast, hGap := bitsetSpan(c.hour)
+	mFirst, mLast, mGap := bitsetSpan(c.minute)
+	sFirst, sLast, sGap := bitsetSpan(c.second)
+
+	// Firings within a day are ordered by hour, then minute, then second,
+	// so the closest pairs are neighbouring seconds of the same minute,
+	// the last and first seconds of neighbouring minutes, or the last and
+	// first times of neighbouring hours.
+	gap := sGap
+	if mGap != -1 {
+		gap = minGap(gap, mGap*60-(sLast-sFirst))
+	}
+	if hGap != -1 {
+		gap = minGap(gap, hGap*3600-(mLast*60+sLast-mFirst*60-sFirst))
+	}
+
+	span := hLast*3600 + mLast*60 + sLast - (hFirst*3600 + mFirst*60 + sFirst)
+	if gap != -1 && gap <= day-span {
+		return time.Duration(gap) * time.Second
+	}
+
+	days := c.minDayInterval()
+	if days == -1 {
+		return math.MaxInt64
+	}
+	return time.Duration(minGap(gap, days*day-span)) * time.Second
+}
+
+// minDayInterval walks a full 400-year Gregorian cycle, after which
+// both dates and weekdays repeat, and returns the smallest number of days
+// between two consecutive firing days, or -1 if the schedule never fires.
+func (c *cronSchedule) minDayInterval() int {
+	const cycle = 146097
+
+	// dows[wd] holds the days of a month that starts on weekday wd
+	// which fall on one of the scheduled weekdays.
+	var dows [7]uint64
+	for wd := range dows {
+		for d := 1; d <= 31; d++ {
+			if c.dow&(1<<((wd+d-1)%7)) != 0 {
+				dows[wd] |= 1 << d
+			}
+		}
+	}
+
+	var (
+		first, prev = -1, -1
+		gap         = -1
+		n           = 0
+		wd          = int(time.Saturday)
+	)
+	for y := 2000; y < 2400; y++ {
+		for m := time.January; m <= time.December; m++ {
+			days := daysInMonth(y, m)
+			if c.month&(1<<m) != 0 {
+				set := c.dom | dows[wd]
+				if c.domStar || c.dowStar {
+					set = c.dom & dows[wd]
+				}
+				set &= 1<<(days+1) - 1
+
+				for ; set != 0; set &= set - 1 {
+					day := n + bits.TrailingZeros64(set)
+					if first == -1 {
+						first = day
+					} else {
+						gap = minGap(gap, day-prev)
+					}
+					if gap == 1 {
+						return 1
+					}
+					prev = day
+				}
+			}
+			n, wd = n+days, (wd+days)%7
+		}
+	}
+
+	if first == -1 {
+		return -1
+	}
+	return minGap(gap, first+cycle-prev)
+}
+
+// bitsetSpan returns the lowest and highest members of a non-empty set
+// and the smallest difference between neighbouring members,
+// or -1 if the set has a single member.
+func bitsetSpan(set uint64) (first, last, gap int) {
+	first, last, gap = bits.TrailingZeros64(set), 63-bits.LeadingZeros64(set), -1
+	prev := first
+	for set &= set - 1; set != 0; set &= set - 1 {
+		i := bits.TrailingZeros64(set)
+		gap = minGap(gap, i-prev)
+		prev = i
+	}
+	return first, last, gap
+}
+
+// minGap returns the smaller of two gaps, where -1 means no gap.
+func minGap(a, b int) int {
+	if a == -1 || b < a {
+		return b
+	}
+	return a
+}
+
+func daysInMonth(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
+	}
+}
+
+func buildMinIntervalError(code string, d time.Duration) error {
+	return validation.NewRuleError(code, "must not fire more often than every "+d.String())
+}
@@ -0,0 +1,94 @@
+package isstr_test
+
+import (
+	"testing"
+	"time"
+
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_Cron(t *testing.T) {
+	tests := []struct {
+		value string
+		want  error
+	}{
+		{"* * * * *", nil},
+		{"*/15 9-17 * * MON-FRI", nil},
+		{"0 0 1,15 jan,jul *", nil},
+		{"30 */2 * * * 7", nil},
+		{"0 0 12 * * SUN", nil},
+		{"5/10 * * * *", nil},
+		{"@hourly", nil},
+		{"@Daily", nil},
+		{"0 0 29 2 *", nil},
+		{"0 0 30 2 *", isstr.ErrCron},
+		{"0 0 31 4,6 *", isstr.ErrCron},
+		{"0 0 30 2 1", nil},
+		{"60 * * * *", isstr.ErrCron},
+		{"* 24 * * *", isstr.ErrCron},
+		{"* * 0 * *", isstr.ErrCron},
+		{"* * * 13 *", isstr.ErrCron},
+		{"* * * * 8", isstr.ErrCron},
+		{"*/0 * * * *", isstr.ErrCron},
+		{"5-1 * * * *", isstr.ErrCron},
+		{"1,,2 * * * *", isstr.ErrCron},
+		{"* * * *", isstr.ErrCron},
+		{"* * * * * * *", isstr.ErrCron},
+		{"@reboot", isstr.ErrCron},
+		{"", isstr.ErrCron},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := isstr.Cron(tt.value); got != tt.want {
+				t.Errorf("Cron(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_CronMinInterval(t *testing.T) {
+	tests := []struct {
+		value    string
+		interval time.Duration
+		wantErr  bool
+	}{
+		{"* * * * *", time.Minute, false},
+		{"* * * * *", 2 * time.Minute, true},
+		{"*/5 * * * * *", 5 * time.Second, false},
+		{"*/5 * * * * *", 10 * time.Second, true},
+		{"0,50 * * * *", 10 * time.Minute, false},
+		{"0,50 * * * *", 11 * time.Minute, true},
+		{"0 9,17 * * *", 8 * time.Hour, false},
+		{"0 9,17 * * *", 9 * time.Hour, true},
+		{"0 23 * * *", 24 * time.Hour, false},
+		{"0 0,23 * * *", 2 * time.Hour, true},
+		{"0 0,23 * * MON", 23 * time.Hour, false},
+		{"0 0,23 * * MON,TUE", time.Hour, false},
+		{"0 0,23 * * MON,TUE", 2 * time.Hour, true},
+		{"0 12 * * MON,WED", 48 * time.Hour, false},
+		{"0 12 * * MON,WED", 72 * time.Hour, true},
+		{"0 0 29 2 *", 4 * 365 * 24 * time.Hour, false},
+		{"@weekly", 7 * 24 * time.Hour, false},
+		{"0 0 30 2 *", time.Minute, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := isstr.CronMinInterval[string](tt.interval).Validate(tt.value)
+			if (got != nil) != tt.wantErr {
+				t.Errorf("CronMinInterval(%v).Validate(%q) = %v, wantErr %v", tt.interval, tt.value, got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Benchmark_CronMinInterval(b *testing.B) {
+	rule := isstr.CronMinInterval[string](time.Hour)
+	for _, expr := range []string{"* * * * * *", "*/5 * * * *", "0 0 1 * *", "0 0 29 2 *", "0 9 * * 1-5"} {
+		b.Run(expr, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				_ = rule(expr)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package isstr
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/infastin/go-validation"
+)
+
+var ErrDecimal = validation.NewRuleError("is_decimal", "must be a valid decimal number")
+
+const maxDecimalExponent = 10000
+
+type decimalRule[T ~string] struct {
+	precision, scale int
+	sign, exponent   bool
+	rules            []validation.BigRule[*big.Rat]
+}
+
+func Decimal[T ~string](precision, scale int) decimalRule[T] {
+	return decimalRule[T]{
+		precision: precision,
+		scale:     scale,
+		sign:      false,
+		exponent:  false,
+		rules:     nil,
+	}
+}
+
+func (r decimalRule[T]) AllowSign() decimalRule[T] {
+	r.sign = true
+	return r
+}
+
+func (r decimalRule[T]) AllowExponent() decimalRule[T] {
+	r.exponent = true
+	return r
+}
+
+func (r decimalRule[T]) ValueBy(rules ...validation.BigRule[*big.Rat]) decimalRule[T] {
+	r.rules = append(r.rules[:len(r.rules):len(r.rules)], rules...)
+	return r
+}
+
+func (r decimalRule[T]) Validate(v T) error {
+	intDigits, fracDigits, ok := parseDecimal(string(v), r.sign, r.exponent)
+	if !ok {
+		return ErrDecimal
+	}
+
+	if r.scale >= 0 && fracDigits > r.scale {
+		return buildDecimalDigitsError("too_many_fraction_digits", r.scale, " after the decimal point")
+	}
+
+	if r.precision > 0 {
+		if r.scale < 0 {
+			if intDigits+fracDigits > r.precision {
+				return buildDecimalDigitsError("too_many_digits", r.precision, "")
+			}
+		} else if intDigits > r.precision-r.scale {
+			return buildDecimalDigitsError("too_many_integer_digits", r.precision-r.scale, " before the decimal point")
+		}
+	}
+
+	if len(r.rules) == 0 {
+		return nil
+	}
+
+	n, ok := new(big.Rat).SetString(string(v))
+	if !ok {
+		return ErrDecimal
+	}
+
+	for _, rule := range r.rules {
+		if err := rule.Validate(n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseDecimal returns the number of significant digits before and after
+// the decimal point, ignoring leading and trailing zeros.
+func parseDecimal(s string, sign, exponent bool) (intDigits, fracDigits int, ok bool) {
+	i := 0
+	if sign && i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+
+	intStart := i
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	intEnd := i
+	if intStart == intEnd {
+		return 0, 0, false
+	}
+
+	fracStart, fracEnd := i, i
+	if i < len(s) && s[i] == '.' {
+		i++
+		fracStart = i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		fracEnd = i
+		if fracStart == fracEnd {
+			return 0, 0, false
+		}
+	}
+
+	exp := 0
+	if exponent && i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e < -maxDecimalExponent || e > maxDecimalExponent {
+			return 0, 0, false
+		}
+		exp = e
+		i = len(s)
+	}
+
+	if i != len(s) {
+		return 0, 0, false
+	}
+
+	nInt := intEnd - intStart
+	digit := func(k int) byte {
+		if k < nInt {
+			return s[intStart+k]
+		}
+		return s[fracStart+k-nInt]
+	}
+
+	var (
+		lo, hi = 0, nInt + fracEnd - fracStart
+		point  = nInt + exp
+	)
+
+	for lo < hi && digit(lo) == '0' {
+		lo++
+	}
+	for hi > lo && hi > point && digit(hi-1) == '0' {
+		hi--
+	}
+
+	if lo == hi {
+		return 0, 0, true
+	}
+
+	return max(point-lo, 0), max(hi-point, 0), true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func buildDecimalDigitsError(code string, max int, suffix string) error {
+	return validation.NewRuleError(code, "must have no more than "+strconv.Itoa(max)+" digits"+suffix)
+}
@@ -0,0 +1,61 @@
+package isstr_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_Decimal_Validate(t *testing.T) {
+	var (
+		numeric  = isstr.Decimal[string](6, 2)
+		signed   = numeric.AllowSign()
+		exponent = signed.AllowExponent()
+		ranged   = signed.ValueBy(validation.BetweenEqualBig(big.NewRat(-1, 1), big.NewRat(1000, 1)))
+	)
+	tests := []struct {
+		name  string
+		rule  validation.StringRule[string]
+		value string
+		want  string
+	}{
+		{"integer", numeric, "1234", ""},
+		{"fraction", numeric, "1234.50", ""},
+		{"trailing zeros", numeric, "1.500000", ""},
+		{"leading zeros", numeric, "0001.5", ""},
+		{"zero", numeric, "0.000", ""},
+		{"too many fraction digits", numeric, "1.234", "must have no more than 2 digits after the decimal point"},
+		{"too many integer digits", numeric, "12345.6", "must have no more than 4 digits before the decimal point"},
+		{"empty", numeric, "", "must be a valid decimal number"},
+		{"dot only", numeric, "1.", "must be a valid decimal number"},
+		{"leading dot", numeric, ".5", "must be a valid decimal number"},
+		{"letters", numeric, "12a", "must be a valid decimal number"},
+		{"sign not allowed", numeric, "-1", "must be a valid decimal number"},
+		{"sign", signed, "-1.25", ""},
+		{"exponent not allowed", signed, "1e3", "must be a valid decimal number"},
+		{"exponent", exponent, "1.5e3", ""},
+		{"negative exponent", exponent, "125e-2", ""},
+		{"exponent too many integer digits", exponent, "1e4", "must have no more than 4 digits before the decimal point"},
+		{"exponent too many fraction digits", exponent, "1e-3", "must have no more than 2 digits after the decimal point"},
+		{"in range", ranged, "999.99", ""},
+		{"out of range", ranged, "1000.01", "must inclusively be between -1 and 1000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.rule.Validate(tt.value); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("Decimal.Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	err := validation.String("12.345", "amount").By(numeric).Valid()
+	if code := err.(validation.ValueError).Unwrap().(validation.RuleError).Code(); code != "too_many_fraction_digits" {
+		t.Errorf("Decimal.Validate() code = %v, want too_many_fraction_digits", code)
+	}
+}
@@ -0,0 +1,31 @@
+package isstr
+
+import (
+	"time"
+
+	"github.com/infastin/go-validation"
+)
+
+var ErrDuration = validation.NewRuleError("is_duration", "must be a valid duration")
+
+func Duration[T ~string](v T) error {
+	if _, err := time.ParseDuration(string(v)); err != nil {
+		return ErrDuration
+	}
+	return nil
+}
+
+func DurationBy[T ~string](rules ...validation.DurationRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		d, err := time.ParseDuration(string(v))
+		if err != nil {
+			return ErrDuration
+		}
+		for _, rule := range rules {
+			if err := rule.Validate(d); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,242 @@
+package isstr
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// isEmail checks the dot-atom or quoted-string local part and the domain
+// of an address as described by RFC 5322 and RFC 6531.
+func isEmail(s string) bool {
+	// Atoms never start with a quote or white space.
+	if len(s) != 0 && strings.IndexByte("\"\t\r ", s[0]) != -1 {
+		return isQuotedEmail(s)
+	}
+	i := strings.IndexByte(s, '@')
+	if i == -1 || !isDotAtom(s[:i]) {
+		return false
+	}
+	return isEmailDomain(s[i+1:])
+}
+
+// isEmailUnicode reports whether r belongs to the non-ASCII ranges allowed
+// in atoms. Invalid UTF-8 decodes to utf8.RuneError, which is out of range.
+func isEmailUnicode(r rune) bool {
+	return 0x00a0 <= r && r <= 0xd7ff || 0xf900 <= r && r <= 0xfdcf || 0xfdf0 <= r && r <= 0xffef
+}
+
+func isAtext(r rune) bool {
+	if r < utf8.RuneSelf {
+		c := byte(r)
+		return isAlnumByte(c) || strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) != -1
+	}
+	return isEmailUnicode(r)
+}
+
+func isDotAtom(s string) bool {
+	prev := byte('.')
+	for i := 0; i < len(s); {
+		if s[i] == '.' {
+			if prev == '.' {
+				return false
+			}
+			prev = '.'
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !isAtext(r) {
+			return false
+		}
+		prev = 0
+		i += size
+	}
+	return prev != '.'
+}
+
+// States of the quoted local part matcher.
+const (
+	quotedChunk   = 1 << iota // before the quote that opens a chunk or closes the string
+	quotedContent             // after the opening quote of a chunk
+	quotedText                // before the text of a chunk
+	quotedPair                // after an opening parenthesis
+	quotedWS                  // in the white space of a chunk, before an optional CRLF
+	quotedCR                  // in the white space of a chunk, after CR
+	quotedLF                  // in the white space of a chunk, after CRLF
+	quotedRun                 // in the trailing white space run of a chunk
+	quotedTailWS              // in the white space before the closing quote, before an optional CRLF
+	quotedTailCR              // in the white space before the closing quote, after CR
+	quotedTailLF              // in the white space before the closing quote, after CRLF
+	quotedTailRun             // in the trailing white space run before the closing quote
+	quotedClose               // before the closing quote
+	quotedDone                // after the closing quote
+)
+
+// isQuotedEmail checks an address whose local part is quoted. Compatibility
+// with the previous implementation requires a rather unusual grammar:
+//
+//	local = *(DQUOTE [FWS] (qtext / "(" (%x01-09 / %x0B-7F))) [FWS] DQUOTE
+//
+// Since the grammar is ambiguous, all possible states are tracked at once.
+func isQuotedEmail(s string) bool {
+	state := quotedClosure(quotedChunk)
+	for i := 0; i < len(s) && state != 0; {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+
+		var next int
+		if r == '"' && state&quotedChunk != 0 {
+			next |= quotedContent
+		}
+		if r == '"' && state&quotedClose != 0 {
+			next |= quotedDone
+		}
+		if state&quotedText != 0 && isQtext(r) {
+			next |= quotedChunk
+		}
+		if state&quotedText != 0 && r == '(' {
+			next |= quotedPair
+		}
+		if state&quotedPair != 0 && r != 0 && r != '\n' && r < utf8.RuneSelf {
+			next |= quotedChunk
+		}
+		next |= quotedFWS(state, r, quotedWS, quotedCR, quotedLF, quotedRun)
+		next |= quotedFWS(state, r, quotedTailWS, quotedTailCR, quotedTailLF, quotedTailRun)
+
+		state = quotedClosure(next)
+		if state&quotedDone != 0 && i < len(s) && s[i] == '@' && isEmailDomain(s[i+1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func quotedClosure(state int) int {
+	if state&quotedChunk != 0 {
+		state |= quotedTailWS | quotedClose
+	}
+	if state&quotedContent != 0 {
+		state |= quotedWS | quotedText
+	}
+	if state&quotedRun != 0 {
+		state |= quotedText
+	}
+	if state&quotedTailRun != 0 {
+		state |= quotedClose
+	}
+	return state
+}
+
+// quotedFWS advances the folding white space [*WSP CRLF] 1*WSP.
+func quotedFWS(state int, r rune, ws, cr, lf, run int) int {
+	var next int
+	switch r {
+	case ' ', '\t':
+		if state&ws != 0 {
+			next |= ws | run
+		}
+		if state&(lf|run) != 0 {
+			next |= run
+		}
+	case '\r':
+		if state&ws != 0 {
+			next |= cr
+		}
+	case '\n':
+		if state&cr != 0 {
+			next |= lf
+		}
+	}
+	return next
+}
+
+func isQtext(r rune) bool {
+	switch {
+	case r >= utf8.RuneSelf:
+		return isEmailUnicode(r)
+	case r == 0, r == '\t', r == '\n', r == '\r', r == ' ', r == '"', r == '\\':
+		return false
+	default:
+		return true
+	}
+}
+
+func isEmailDomain(s string) bool {
+	if len(s) != 0 && s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+
+	i := strings.LastIndexByte(s, '.')
+	if i <= 0 {
+		return false
+	}
+
+	// Everything up to the last dot is treated as one run of labels,
+	// the top-level domain must not contain digits.
+	return isDomainRun(s[:i], true) && isDomainRun(s[i+1:], false)
+}
+
+func isDomainRun(s string, digits bool) bool {
+	if s == "" {
+		return false
+	}
+
+	var last rune
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case isDomainEdge(r, digits):
+		case i == 0:
+			return false
+		case r == '-', r == '_', r == '~', r == '.' && digits, '0' <= r && r <= '9':
+		default:
+			return false
+		}
+		last = r
+		i += size
+	}
+
+	return isDomainEdge(last, digits)
+}
+
+func isDomainEdge(r rune, digits bool) bool {
+	if r >= utf8.RuneSelf {
+		return isEmailUnicode(r)
+	}
+	return isAlphaByte(byte(r)) || digits && isDigit(byte(r))
+}
+
+func isExistingEmail(s string) bool {
+	if len(s) < 6 || len(s) > 254 {
+		return false
+	}
+
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 || at > len(s)-3 {
+		return false
+	}
+
+	user, host := s[:at], s[at+1:]
+	if len(user) > 64 {
+		return false
+	}
+	switch host {
+	case "localhost", "example.com":
+		return true
+	}
+
+	return isExistingEmailUser(user) && isExistingEmailHost(host)
+}
+
+func isExistingEmailUser(s string) bool {
+	if s[0] == '.' || s[len(s)-1] == '.' || strings.Contains(s, "..") {
+		return false
+	}
+	return isEvery(s, func(c byte) bool {
+		return isAlnumByte(c) || strings.IndexByte("!#$%&'*+/=?^_`{|}~.-", c) != -1
+	})
+}
+
+func isExistingEmailHost(s string) bool {
+	return !strings.ContainsAny(s, " \t\n\f\r") && strings.IndexByte(s[1:len(s)-1], '.') != -1
+}
@@ -0,0 +1,215 @@
+package isstr
+
+import (
+	"strconv"
+
+	"github.com/infastin/go-validation"
+	"github.com/infastin/go-validation/checkdigit"
+)
+
+var (
+	ErrIBAN         = validation.NewRuleError("is_iban", "must be a valid IBAN")
+	ErrIBANCountry  = validation.NewRuleError("iban_country", "must be an IBAN of a supported country")
+	ErrIBANFormat   = validation.NewRuleError("iban_format", "must match the IBAN format of its country")
+	ErrIBANChecksum = validation.NewRuleError("iban_checksum", "must be an IBAN with valid check digits")
+	ErrBIC          = validation.NewRuleError("is_bic", "must be a valid BIC")
+	ErrBICLength    = validation.NewRuleError("bic_length", "must be a BIC of 8 or 11 characters")
+	ErrBICCountry   = validation.NewRuleError("bic_country", "must be a BIC of a known country")
+)
+
+// IBAN checks an International Bank Account Number in the electronic format,
+// that is, in upper case and without spaces, as described by ISO 13616.
+func IBAN[T ~string](v T) error {
+	s := string(v)
+	if len(s) < 5 || !isUpperAlphaByte(s[0]) || !isUpperAlphaByte(s[1]) || !isDigit(s[2]) || !isDigit(s[3]) ||
+		!isEvery(s[4:], isUpperAlnumByte) {
+		return ErrIBAN
+	}
+
+	bban, ok := ibanFormats[s[:2]]
+	if !ok {
+		return ErrIBANCountry
+	}
+	if length := 4 + bbanLength(bban); len(s) != length {
+		return buildIBANLengthError(s[:2], length)
+	}
+	if !matchBBAN(s[4:], bban) {
+		return ErrIBANFormat
+	}
+
+	// The country code and check digits are moved to the end.
+	var buf [34]byte
+	if r, _ := checkdigit.Mod97(append(append(buf[:0], s[4:]...), s[:4]...)); r != 1 {
+		return ErrIBANChecksum
+	}
+
+	return nil
+}
+
+// BIC checks a Business Identifier Code as described by ISO 9362:
+// a four-letter institution code, a country code, a two-character
+// location code and an optional three-character branch code.
+func BIC[T ~string](v T) error {
+	s := string(v)
+	if len(s) != 8 && len(s) != 11 {
+		return ErrBICLength
+	}
+	if !isEvery(s[:6], isUpperAlphaByte) || !isEvery(s[6:], isUpperAlnumByte) {
+		return ErrBIC
+	}
+	// Kosovo has no ISO 3166 code yet, but is assigned XK by SWIFT.
+	if country := s[4:6]; country != "XK" && !inCodeSet(countryCodes2L, country) {
+		return ErrBICCountry
+	}
+	return nil
+}
+
+func buildIBANLengthError(country string, length int) error {
+	return validation.NewRuleErrorParams("iban_length", "must be "+strconv.Itoa(length)+" characters long", map[string]any{
+		"country": country,
+		"length":  length,
+	})
+}
+
+func isUpperAlphaByte(c byte) bool {
+	return 'A' <= c && c <= 'Z'
+}
+
+func isUpperAlnumByte(c byte) bool {
+	return isUpperAlphaByte(c) || isDigit(c)
+}
+
+// bbanLength returns the number of characters described by a BBAN format.
+func bbanLength(format string) int {
+	n := 0
+	for len(format) != 0 {
+		var count int
+		count, _, format = nextBBANField(format)
+		n += count
+	}
+	return n
+}
+
+// matchBBAN matches s against a BBAN format such as "4a6n8c",
+// where a stands for letters, n for digits and c for both.
+// The length must be checked beforehand.
+func matchBBAN(s, format string) bool {
+	for len(format) != 0 {
+		var (
+			count int
+			class byte
+		)
+		count, class, format = nextBBANField(format)
+		for i := range count {
+			switch c := s[i]; {
+			case class == 'n' && !isDigit(c), class == 'a' && !isUpperAlphaByte(c):
+				return false
+			}
+		}
+		s = s[count:]
+	}
+	return true
+}
+
+func nextBBANField(format string) (count int, class byte, rest string) {
+	i := 0
+	for isDigit(format[i]) {
+		count = count*10 + int(format[i]-'0')
+		i++
+	}
+	return count, format[i], format[i+1:]
+}
+
+// ibanFormats maps country codes to the BBAN formats
+// published in the SWIFT IBAN registry.
+var ibanFormats = map[string]string{
+	"AD": "4n4n12c",
+	"AE": "3n16n",
+	"AL": "8n16c",
+	"AT": "5n11n",
+	"AZ": "4a20c",
+	"BA": "3n3n8n2n",
+	"BE": "3n7n2n",
+	"BG": "4a4n2n8c",
+	"BH": "4a14c",
+	"BI": "5n5n11n2n",
+	"BR": "8n5n10n1a1c",
+	"BY": "4c4n16c",
+	"CH": "5n12c",
+	"CR": "4n14n",
+	"CY": "3n5n16c",
+	"CZ": "4n6n10n",
+	"DE": "8n10n",
+	"DJ": "5n5n11n2n",
+	"DK": "4n9n1n",
+	"DO": "4a20n",
+	"EE": "2n2n11n1n",
+	"EG": "4n4n17n",
+	"ES": "4n4n1n1n10n",
+	"FI": "3n11n",
+	"FK": "2a12n",
+	"FO": "4n9n1n",
+	"FR": "5n5n11c2n",
+	"GB": "4a6n8n",
+	"GE": "2a16n",
+	"GI": "4a15c",
+	"GL": "4n9n1n",
+	"GR": "3n4n16c",
+	"GT": "4c20c",
+	"HN": "4a20n",
+	"HR": "7n10n",
+	"HU": "3n4n1n15n1n",
+	"IE": "4a6n8n",
+	"IL": "3n3n13n",
+	"IQ": "4a3n12n",
+	"IS": "4n2n6n10n",
+	"IT": "1a5n5n12c",
+	"JO": "4a4n18c",
+	"KW": "4a22c",
+	"KZ": "3n13c",
+	"LB": "4n20c",
+	"LC": "4a24c",
+	"LI": "5n12c",
+	"LT": "5n11n",
+	"LU": "3n13c",
+	"LV": "4a13c",
+	"LY": "3n3n15n",
+	"MC": "5n5n11c2n",
+	"MD": "2c18c",
+	"ME": "3n13n2n",
+	"MK": "3n10c2n",
+	"MN": "4n12n",
+	"MR": "5n5n11n2n",
+	"MT": "4a5n18c",
+	"MU": "4a2n2n12n3n3a",
+	"NI": "4a20n",
+	"NL": "4a10n",
+	"NO": "4n6n1n",
+	"OM": "3n16c",
+	"PK": "4a16c",
+	"PL": "8n16n",
+	"PS": "4a21c",
+	"PT": "4n4n11n2n",
+	"QA": "4a21c",
+	"RO": "4a16c",
+	"RS": "3n13n2n",
+	"RU": "9n5n15c",
+	"SA": "2n18c",
+	"SC": "4a2n2n16n3a",
+	"SD": "2n12n",
+	"SE": "3n16n1n",
+	"SI": "5n8n2n",
+	"SK": "4n6n10n",
+	"SM": "1a5n5n12c",
+	"SO": "4n3n12n",
+	"ST": "4n4n11n2n",
+	"SV": "4a20n",
+	"TL": "3n14n2n",
+	"TN": "2n3n13n2n",
+	"TR": "5n1n16c",
+	"UA": "6n19c",
+	"VA": "3n15n",
+	"VG": "4a16n",
+	"XK": "4n10n2n",
+	"YE": "4a4n18c",
+}
@@ -0,0 +1,75 @@
+package isstr_test
+
+import (
+	"testing"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_IBAN(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"germany", "DE89370400440532013000", ""},
+		{"united kingdom", "GB82WEST12345698765432", ""},
+		{"france", "FR1420041010050500013M02606", ""},
+		{"netherlands", "NL91ABNA0417164300", ""},
+		{"norway", "NO9386011117947", ""},
+		{"malta", "MT84MALT011000012345MTLCAST001S", ""},
+		{"mauritius", "MU17BOMM0101101030300200000MUR", ""},
+		{"lower case", "de89370400440532013000", "is_iban"},
+		{"spaces", "DE89 3704 0044 0532 0130 00", "is_iban"},
+		{"too short", "DE89", "is_iban"},
+		{"unknown country", "US64SVBKUS6S3300958879", "iban_country"},
+		{"too long", "DE893704004405320130001", "iban_length"},
+		{"too short for country", "NL91ABNA041716430", "iban_length"},
+		{"letters in numeric part", "GB82WEST1234569876543A", "iban_format"},
+		{"digits in bank code", "GB82W3ST12345698765432", "iban_format"},
+		{"checksum", "DE89370400440532013001", "iban_checksum"},
+		{"check digits", "DE98370400440532013000", "iban_checksum"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := isstr.IBAN(tt.value); err != nil {
+				got = err.(validation.RuleError).Code()
+			}
+			if got != tt.want {
+				t.Errorf("IBAN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	err := isstr.IBAN("NL91ABNA041716430").(validation.ParamsError)
+	if err.Error() != "must be 18 characters long" || err.Params()["country"] != "NL" || err.Params()["length"] != 18 {
+		t.Errorf("IBAN() = %v %v, want length error for NL", err, err.Params())
+	}
+}
+
+func Test_BIC(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  error
+	}{
+		{"primary office", "DEUTDEFF", nil},
+		{"branch", "DEUTDEFF500", nil},
+		{"location digits", "NEDSZAJJ", nil},
+		{"kosovo", "RBKOXKPR", nil},
+		{"too short", "DEUTDEF", isstr.ErrBICLength},
+		{"too long", "DEUTDEFF5000", isstr.ErrBICLength},
+		{"digit in institution", "DEU1DEFF", isstr.ErrBIC},
+		{"lower case", "deutdeff", isstr.ErrBIC},
+		{"unknown country", "DEUTZZFF", isstr.ErrBICCountry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isstr.BIC(tt.value); got != tt.want {
+				t.Errorf("BIC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package isstr
+
+import (
+	"strings"
+
+	"github.com/infastin/go-validation"
+	"github.com/infastin/go-validation/checkdigit"
+)
+
+var (
+	ErrEAN8   = validation.NewRuleError("is_ean_8", "must be a valid EAN-8")
+	ErrEAN13  = validation.NewRuleError("is_ean_13", "must be a valid EAN-13")
+	ErrUPCA   = validation.NewRuleError("is_upc_a", "must be a valid UPC-A")
+	ErrGTIN14 = validation.NewRuleError("is_gtin_14", "must be a valid GTIN-14")
+	ErrISSN   = validation.NewRuleError("is_issn", "must be a valid ISSN")
+	ErrISIN   = validation.NewRuleError("is_isin", "must be a valid ISIN")
+	ErrCUSIP  = validation.NewRuleError("is_cusip", "must be a valid CUSIP")
+	ErrSEDOL  = validation.NewRuleError("is_sedol", "must be a valid SEDOL")
+	ErrLEI    = validation.NewRuleError("is_lei", "must be a valid LEI")
+	ErrVIN    = validation.NewRuleError("is_vin", "must be a valid VIN")
+
+	ErrNorthAmericanVIN = validation.NewRuleError("is_north_american_vin", "must be a valid North American VIN")
+)
+
+// EAN8 checks an eight-digit European Article Number.
+func EAN8[T ~string](v T) error {
+	if !isGTIN(string(v), 8) {
+		return ErrEAN8
+	}
+	return nil
+}
+
+// EAN13 checks a thirteen-digit European Article Number.
+func EAN13[T ~string](v T) error {
+	if !isGTIN(string(v), 13) {
+		return ErrEAN13
+	}
+	return nil
+}
+
+// UPCA checks a twelve-digit Universal Product Code.
+func UPCA[T ~string](v T) error {
+	if !isGTIN(string(v), 12) {
+		return ErrUPCA
+	}
+	return nil
+}
+
+// GTIN14 checks a fourteen-digit Global Trade Item Number.
+func GTIN14[T ~string](v T) error {
+	if !isGTIN(string(v), 14) {
+		return ErrGTIN14
+	}
+	return nil
+}
+
+// ISSN checks an International Standard Serial Number,
+// with or without the hyphen after the fourth digit.
+func ISSN[T ~string](v T) error {
+	s := string(v)
+	if len(s) == 9 && s[4] == '-' {
+		s = s[:4] + s[5:]
+	}
+	if len(s) != 8 || !checkdigit.Mod11(s) {
+		return ErrISSN
+	}
+	return nil
+}
+
+// ISIN checks an International Securities Identification Number
+// as described by ISO 6166.
+func ISIN[T ~string](v T) error {
+	s := string(v)
+	if len(s) != 12 || !isUpperAlphaByte(s[0]) || !isUpperAlphaByte(s[1]) ||
+		!isEvery(s[2:11], isUpperAlnumByte) || !isDigit(s[11]) {
+		return ErrISIN
+	}
+
+	// Letters are expanded to two digits before the Luhn algorithm is applied.
+	var buf [24]byte
+	n := buf[:0]
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; isDigit(c) {
+			n = append(n, c)
+		} else {
+			d := c - 'A' + 10
+			n = append(n, '0'+d/10, '0'+d%10)
+		}
+	}
+	if !checkdigit.Luhn(n) {
+		return ErrISIN
+	}
+
+	return nil
+}
+
+// CUSIP checks a nine-character CUSIP security identifier.
+func CUSIP[T ~string](v T) error {
+	s := string(v)
+	if len(s) != 9 || !isDigit(s[8]) {
+		return ErrCUSIP
+	}
+
+	sum := 0
+	for i := 0; i < 8; i++ {
+		var d int
+		switch c := s[i]; {
+		case isDigit(c):
+			d = int(c - '0')
+		case isUpperAlphaByte(c):
+			d = int(c-'A') + 10
+		case c == '*', c == '@', c == '#':
+			d = 36 + strings.IndexByte("*@#", c)
+		default:
+			return ErrCUSIP
+		}
+		if i%2 == 1 {
+			d *= 2
+		}
+		sum += d/10 + d%10
+	}
+	if (10-sum%10)%10 != int(s[8]-'0') {
+		return ErrCUSIP
+	}
+
+	return nil
+}
+
+// SEDOL checks a Stock Exchange Daily Official List code.
+func SEDOL[T ~string](v T) error {
+	s := string(v)
+	if len(s) != 7 || !isEvery(s[:6], isUpperAlnumByte) || strings.ContainsAny(s[:6], "AEIOU") ||
+		!checkdigit.Mod10(s, 9, 3, 7, 1, 3, 1) {
+		return ErrSEDOL
+	}
+	return nil
+}
+
+// LEI checks a Legal Entity Identifier as described by ISO 17442.
+func LEI[T ~string](v T) error {
+	s := string(v)
+	if len(s) != 20 || !isEvery(s[:18], isUpperAlnumByte) || !isEvery(s[18:], isDigit) {
+		return ErrLEI
+	}
+	if r, _ := checkdigit.Mod97(s); r != 1 {
+		return ErrLEI
+	}
+	return nil
+}
+
+// VIN checks the structure of a Vehicle Identification Number as described
+// by ISO 3779: 17 digits or capital letters other than I, O and Q.
+func VIN[T ~string](v T) error {
+	if !isVIN(string(v)) {
+		return ErrVIN
+	}
+	return nil
+}
+
+// NorthAmericanVIN checks a VIN including the check digit in the ninth position,
+// which is mandatory in North America but not used by most other countries.
+func NorthAmericanVIN[T ~string](v T) error {
+	s := string(v)
+	if !isVIN(s) {
+		return ErrNorthAmericanVIN
+	}
+
+	weights := [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		d := int(s[i] - '0')
+		if !isDigit(s[i]) {
+			d = int(vinValues[s[i]-'A'] - '0')
+		}
+		sum += weights[i] * d
+	}
+	if "0123456789X"[sum%11] != s[8] {
+		return ErrNorthAmericanVIN
+	}
+
+	return nil
+}
+
+func isVIN(s string) bool {
+	return len(s) == 17 && isEvery(s, func(c byte) bool {
+		return isDigit(c) || isUpperAlphaByte(c) && c != 'I' && c != 'O' && c != 'Q'
+	})
+}
+
+// vinValues transliterates the letters of VINs to numbers.
+const vinValues = "12345678_12345_7_923456789"
+
+func isGTIN(s string, length int) bool {
+	return len(s) == length && isEvery(s, isDigit) && checkdigit.Mod10(s, 3, 1)
+}
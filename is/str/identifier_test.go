@@ -0,0 +1,64 @@
+package isstr_test
+
+import (
+	"testing"
+
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_Identifiers(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  func(string) error
+		value string
+		want  error
+	}{
+		{"ean-8", isstr.EAN8[string], "96385074", nil},
+		{"ean-8 checksum", isstr.EAN8[string], "96385075", isstr.ErrEAN8},
+		{"ean-8 length", isstr.EAN8[string], "4006381333931", isstr.ErrEAN8},
+		{"ean-13", isstr.EAN13[string], "4006381333931", nil},
+		{"ean-13 checksum", isstr.EAN13[string], "4006381333932", isstr.ErrEAN13},
+		{"ean-13 letters", isstr.EAN13[string], "400638133393A", isstr.ErrEAN13},
+		{"upc-a", isstr.UPCA[string], "036000291452", nil},
+		{"upc-a checksum", isstr.UPCA[string], "036000291453", isstr.ErrUPCA},
+		{"gtin-14", isstr.GTIN14[string], "00036000291452", nil},
+		{"gtin-14 checksum", isstr.GTIN14[string], "10036000291452", isstr.ErrGTIN14},
+		{"issn", isstr.ISSN[string], "0378-5955", nil},
+		{"issn without hyphen", isstr.ISSN[string], "03785955", nil},
+		{"issn check digit x", isstr.ISSN[string], "2434-561X", nil},
+		{"issn checksum", isstr.ISSN[string], "0378-5956", isstr.ErrISSN},
+		{"issn misplaced hyphen", isstr.ISSN[string], "037-85955", isstr.ErrISSN},
+		{"isin", isstr.ISIN[string], "US0378331005", nil},
+		{"isin with letters", isstr.ISIN[string], "AU0000XVGZA3", nil},
+		{"isin checksum", isstr.ISIN[string], "US0378331006", isstr.ErrISIN},
+		{"isin lower case", isstr.ISIN[string], "us0378331005", isstr.ErrISIN},
+		{"cusip", isstr.CUSIP[string], "037833100", nil},
+		{"cusip with letters", isstr.CUSIP[string], "38259P508", nil},
+		{"cusip checksum", isstr.CUSIP[string], "037833101", isstr.ErrCUSIP},
+		{"sedol", isstr.SEDOL[string], "0263494", nil},
+		{"sedol with letters", isstr.SEDOL[string], "B0YBKJ7", nil},
+		{"sedol vowel", isstr.SEDOL[string], "A0YBKJ7", isstr.ErrSEDOL},
+		{"sedol checksum", isstr.SEDOL[string], "0263495", isstr.ErrSEDOL},
+		{"lei", isstr.LEI[string], "529900T8BM49AURSDO55", nil},
+		{"lei checksum", isstr.LEI[string], "529900T8BM49AURSDO56", isstr.ErrLEI},
+		{"lei letters in check digits", isstr.LEI[string], "529900T8BM49AURSDOAA", isstr.ErrLEI},
+		{"vin", isstr.VIN[string], "1M8GDM9AXKP042788", nil},
+		{"vin digits", isstr.VIN[string], "11111111111111111", nil},
+		{"vin without check digit", isstr.VIN[string], "WVWZZZ1JZXW000001", nil},
+		{"vin letter o", isstr.VIN[string], "1M8GDM9AXKO042788", isstr.ErrVIN},
+		{"vin lower case", isstr.VIN[string], "1m8gdm9axkp042788", isstr.ErrVIN},
+		{"vin length", isstr.VIN[string], "1M8GDM9AXKP04278", isstr.ErrVIN},
+		{"north american vin", isstr.NorthAmericanVIN[string], "1M8GDM9AXKP042788", nil},
+		{"north american vin digits", isstr.NorthAmericanVIN[string], "11111111111111111", nil},
+		{"north american vin checksum", isstr.NorthAmericanVIN[string], "1M8GDM9A1KP042788", isstr.ErrNorthAmericanVIN},
+		{"north american vin without check digit", isstr.NorthAmericanVIN[string], "WVWZZZ1JZXW000001", isstr.ErrNorthAmericanVIN},
+		{"north american vin letter q", isstr.NorthAmericanVIN[string], "1M8GDM9AXKQ042788", isstr.ErrNorthAmericanVIN},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule(tt.value); got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package isstr
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/infastin/go-validation"
+	"github.com/infastin/go-validation/checkdigit"
+)
+
+var (
+	ErrNationalID        = validation.NewRuleError("is_national_id", "must be a valid national identification number")
+	ErrNationalIDCountry = validation.NewRuleError("national_id_country", "must be a national identification number of a supported country")
+)
+
+// NationalID checks an identification number issued by an ISO 3166 alpha-2 country.
+// A number is valid when it matches any of the documents registered for the country.
+//
+// Built-in documents:
+//   - BR: CPF and CNPJ, including the alphanumeric CNPJ, with or without punctuation.
+//   - CN: resident identity card number.
+//   - ES: DNI and NIE.
+//   - IN: Aadhaar number, optionally grouped with spaces.
+//   - IT: codice fiscale of natural persons.
+//   - US: social security number, as checked by SSN.
+func NationalID[T ~string](country string) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		return validateNationalID(string(v), country)
+	}
+}
+
+// NationalIDFunc is like NationalID, but calls country on every validation,
+// which allows to take the country from another field.
+func NationalIDFunc[T ~string](country func() string) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		return validateNationalID(string(v), country())
+	}
+}
+
+// RegisterNationalID adds a document to the documents accepted by NationalID
+// for country. It is safe for concurrent use, but is usually called from init.
+func RegisterNationalID(country string, check func(id string) bool) {
+	nationalIDs.Lock()
+	defer nationalIDs.Unlock()
+	nationalIDs.checks[country] = append(nationalIDs.checks[country], check)
+}
+
+var nationalIDs = struct {
+	sync.RWMutex
+	checks map[string][]func(id string) bool
+}{
+	checks: map[string][]func(id string) bool{
+		"BR": {isCPF, isCNPJ},
+		"CN": {isChineseResidentID},
+		"ES": {isDNI, isNIE},
+		"IN": {isAadhaar},
+		"IT": {isCodiceFiscale},
+		"US": {isSSN},
+	},
+}
+
+func validateNationalID(s, country string) error {
+	nationalIDs.RLock()
+	checks, ok := nationalIDs.checks[country]
+	nationalIDs.RUnlock()
+
+	if !ok {
+		return ErrNationalIDCountry
+	}
+	for _, check := range checks {
+		if check(s) {
+			return nil
+		}
+	}
+	return ErrNationalID
+}
+
+// isCPF checks the Brazilian individual taxpayer number.
+func isCPF(s string) bool {
+	if matchFormat(s, "nnn.nnn.nnn-nn") {
+		s = s[0:3] + s[4:7] + s[8:11] + s[12:]
+	}
+	if len(s) != 11 || !isEvery(s, isDigit) || strings.Count(s, s[:1]) == len(s) {
+		return false
+	}
+	c1 := weightedSum(s[:9], 10, 9, 8, 7, 6, 5, 4, 3, 2) * 10 % 11 % 10
+	c2 := weightedSum(s[:10], 11, 10, 9, 8, 7, 6, 5, 4, 3, 2) * 10 % 11 % 10
+	return c1 == int(s[9]-'0') && c2 == int(s[10]-'0')
+}
+
+// isCNPJ checks the Brazilian company registration number. Since 2026
+// the first twelve characters may be letters, which count as their
+// ASCII code minus 48.
+func isCNPJ(s string) bool {
+	if matchFormat(s, "cc.ccc.ccc/cccc-nn") {
+		s = s[0:2] + s[3:6] + s[7:10] + s[11:15] + s[16:]
+	}
+	if len(s) != 14 || !isEvery(s[:12], isUpperAlnumByte) || !isEvery(s[12:], isDigit) || s == "00000000000000" {
+		return false
+	}
+	for i, weights := range [2][]int{
+		{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2},
+		{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2},
+	} {
+		sum := 0
+		for j, w := range weights {
+			sum += w * int(s[j]-'0')
+		}
+		c := 0
+		if r := sum % 11; r >= 2 {
+			c = 11 - r
+		}
+		if c != int(s[12+i]-'0') {
+			return false
+		}
+	}
+	return true
+}
+
+// spanishIDLetter returns the control letter of Spanish personal numbers.
+func spanishIDLetter(n int) byte {
+	return "TRWAGMYFPDXBNJZSQVHLCKE"[n%23]
+}
+
+// isDNI checks the Spanish national identity document number.
+func isDNI(s string) bool {
+	return matchFormat(s, "nnnnnnnna") && spanishIDLetter(digitsValue(s[:8])) == s[8]
+}
+
+// isNIE checks the Spanish foreigner identity number.
+func isNIE(s string) bool {
+	return matchFormat(s, "annnnnnna") && strings.IndexByte("XYZ", s[0]) != -1 &&
+		spanishIDLetter(int(s[0]-'X')*10_000_000+digitsValue(s[1:8])) == s[8]
+}
+
+// isCodiceFiscale checks the Italian tax code of natural persons,
+// including codes where digits were replaced with letters to resolve collisions.
+func isCodiceFiscale(s string) bool {
+	if len(s) != 16 || !isEvery(s, isUpperAlnumByte) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; i {
+		case 6, 7, 9, 10, 12, 13, 14:
+			if !isDigit(c) && strings.IndexByte("LMNPQRSTUV", c) == -1 {
+				return false
+			}
+		case 8:
+			if strings.IndexByte("ABCDEHLMPRST", c) == -1 {
+				return false
+			}
+		default:
+			if !isUpperAlphaByte(c) {
+				return false
+			}
+		}
+	}
+
+	// Values of characters in odd positions, digits map to the first ten letters.
+	odd := [26]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+	sum := 0
+	for i := 0; i < 15; i++ {
+		v := int(s[i] - 'A')
+		if isDigit(s[i]) {
+			v = int(s[i] - '0')
+		}
+		if i%2 == 0 {
+			v = odd[v]
+		}
+		sum += v
+	}
+	return byte('A'+sum%26) == s[15]
+}
+
+// isAadhaar checks the Indian unique identity number.
+func isAadhaar(s string) bool {
+	if matchFormat(s, "nnnn nnnn nnnn") {
+		s = s[0:4] + s[5:9] + s[10:]
+	}
+	return len(s) == 12 && isEvery(s, isDigit) && s[0] >= '2' && checkdigit.Verhoeff(s)
+}
+
+// isChineseResidentID checks the number of the resident identity card
+// of the People's Republic of China as described by GB 11643-1999.
+func isChineseResidentID(s string) bool {
+	if len(s) != 18 || !isEvery(s[:17], isDigit) || !isDigit(s[17]) && s[17] != 'X' || s[0] == '0' || s[0] == '9' {
+		return false
+	}
+
+	year, month, day := digitsValue(s[6:10]), digitsValue(s[10:12]), digitsValue(s[12:14])
+	if year < 1900 || month < 1 || month > 12 || day < 1 || day > daysInMonth(year, time.Month(month)) {
+		return false
+	}
+
+	sum := weightedSum(s[:17], 7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2)
+	return "10X98765432"[sum%11] == s[17]
+}
@@ -0,0 +1,93 @@
+package isstr_test
+
+import (
+	"strings"
+	"testing"
+
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_NationalID(t *testing.T) {
+	tests := []struct {
+		name    string
+		country string
+		value   string
+		want    error
+	}{
+		{"cpf", "BR", "52998224725", nil},
+		{"cpf formatted", "BR", "529.982.247-25", nil},
+		{"cpf checksum", "BR", "529.982.247-26", isstr.ErrNationalID},
+		{"cpf repeated digits", "BR", "111.111.111-11", isstr.ErrNationalID},
+		{"cnpj", "BR", "11.222.333/0001-81", nil},
+		{"cnpj unformatted", "BR", "11222333000181", nil},
+		{"cnpj alphanumeric", "BR", "12.ABC.345/01DE-35", nil},
+		{"cnpj checksum", "BR", "11.222.333/0001-82", isstr.ErrNationalID},
+		{"chinese resident id", "CN", "11010519491231002X", nil},
+		{"chinese resident id lower case x", "CN", "11010519491231002x", isstr.ErrNationalID},
+		{"chinese resident id date", "CN", "11010519490231002X", isstr.ErrNationalID},
+		{"chinese resident id checksum", "CN", "110105194912310021", isstr.ErrNationalID},
+		{"dni", "ES", "12345678Z", nil},
+		{"dni letter", "ES", "12345678A", isstr.ErrNationalID},
+		{"nie", "ES", "X2482300W", nil},
+		{"nie letter", "ES", "Y2482300W", isstr.ErrNationalID},
+		{"aadhaar", "IN", "234123412346", nil},
+		{"aadhaar grouped", "IN", "2341 2341 2346", nil},
+		{"aadhaar leading one", "IN", "134123412346", isstr.ErrNationalID},
+		{"aadhaar checksum", "IN", "234123412347", isstr.ErrNationalID},
+		{"codice fiscale", "IT", "RSSMRA85T10A562S", nil},
+		{"codice fiscale omocodia", "IT", "RSSMRA85T10A56NH", nil},
+		{"codice fiscale month", "IT", "RSSMRA85F10A562S", isstr.ErrNationalID},
+		{"codice fiscale checksum", "IT", "RSSMRA85T10A562T", isstr.ErrNationalID},
+		{"ssn", "US", "123-45-6789", nil},
+		{"ssn without hyphens", "US", "123456789", isstr.ErrNationalID},
+		{"unknown country", "ZZ", "123456789", isstr.ErrNationalIDCountry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isstr.NationalID[string](tt.country).Validate(tt.value); got != tt.want {
+				t.Errorf("NationalID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_RegisterNationalID(t *testing.T) {
+	// XA is a user-assigned code without built-in documents.
+	rule := isstr.NationalID[string]("XA")
+	if got := rule.Validate("XA-1"); got != isstr.ErrNationalIDCountry {
+		t.Fatalf("NationalID() = %v, want %v", got, isstr.ErrNationalIDCountry)
+	}
+
+	isstr.RegisterNationalID("XA", func(id string) bool {
+		return strings.HasPrefix(id, "XA-")
+	})
+	isstr.RegisterNationalID("XA", func(id string) bool {
+		return strings.HasPrefix(id, "XB-")
+	})
+
+	for value, want := range map[string]error{
+		"XA-1": nil,
+		"XB-1": nil,
+		"XC-1": isstr.ErrNationalID,
+	} {
+		if got := rule.Validate(value); got != want {
+			t.Errorf("NationalID(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
+
+func Test_NationalIDFunc(t *testing.T) {
+	country := "ES"
+	rule := isstr.NationalIDFunc[string](func() string {
+		return country
+	})
+
+	if got := rule.Validate("12345678Z"); got != nil {
+		t.Errorf("NationalIDFunc() = %v, want %v", got, nil)
+	}
+
+	country = "BR"
+	if got := rule.Validate("12345678Z"); got != isstr.ErrNationalID {
+		t.Errorf("NationalIDFunc() = %v, want %v", got, isstr.ErrNationalID)
+	}
+}
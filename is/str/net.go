@@ -0,0 +1,130 @@
+package isstr
+
+import (
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+func parseIP(s string) (netip.Addr, bool) {
+	if strings.IndexByte(s, ':') == -1 {
+		// Parsing IPv4 addresses natively avoids the error allocated
+		// by netip for every host name checked with this function.
+		return parseIPv4(s)
+	}
+	// Zones are not accepted, rejecting them early
+	// also avoids interning the zone name.
+	if strings.IndexByte(s, '%') != -1 {
+		return netip.Addr{}, false
+	}
+	addr, err := netip.ParseAddr(s)
+	return addr, err == nil
+}
+
+func parseIPv4(s string) (netip.Addr, bool) {
+	var ip [4]byte
+	for i := range ip {
+		var field string
+		field, s = cutAny(s, '.')
+		if i < len(ip)-1 {
+			if s == "" {
+				return netip.Addr{}, false
+			}
+			s = s[1:]
+		}
+		if !isByteValue(field) {
+			return netip.Addr{}, false
+		}
+		for j := 0; j < len(field); j++ {
+			ip[i] = ip[i]*10 + field[j] - '0'
+		}
+	}
+	if s != "" {
+		return netip.Addr{}, false
+	}
+	return netip.AddrFrom4(ip), true
+}
+
+func isIP(s string) bool {
+	_, ok := parseIP(s)
+	return ok
+}
+
+func isIPv4(s string) bool {
+	return isIP(s) && strings.IndexByte(s, '.') != -1
+}
+
+func isIPv6(s string) bool {
+	return isIP(s) && strings.IndexByte(s, ':') != -1
+}
+
+func isCIDR(s string) bool {
+	addr, mask, found := strings.Cut(s, "/")
+	if !found {
+		return false
+	}
+	ip, ok := parseIP(addr)
+	if !ok || mask == "" {
+		return false
+	}
+	n := 0
+	for i := 0; i < len(mask); i++ {
+		if !isDigit(mask[i]) {
+			return false
+		}
+		n = n*10 + int(mask[i]-'0')
+		if n > ip.BitLen() {
+			return false
+		}
+	}
+	return true
+}
+
+func isDNSName(s string) bool {
+	if s == "" || len(s)-strings.Count(s, ".") > 255 || isIP(s) {
+		return false
+	}
+	if isDNSLabels(s) {
+		return true
+	}
+	// A single trailing dot or underscore is allowed after the last label.
+	if c := s[len(s)-1]; c == '.' || c == '_' {
+		return isDNSLabels(s[:len(s)-1])
+	}
+	return false
+}
+
+func isDNSLabels(s string) bool {
+	for {
+		i := strings.IndexByte(s, '.')
+		if i == -1 {
+			i = len(s)
+		}
+		label := s[:i]
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' {
+			return false
+		}
+		if !isEvery(label, func(c byte) bool { return isAlnumByte(c) || c == '_' || c == '-' }) {
+			return false
+		}
+		if i == len(s) {
+			return true
+		}
+		s = s[i+1:]
+	}
+}
+
+func isHost(s string) bool {
+	return isIP(s) || isDNSName(s)
+}
+
+func isPort(s string) bool {
+	n, err := strconv.Atoi(s)
+	return err == nil && n > 0 && n < 65536
+}
+
+func isDialString(s string) bool {
+	host, port, err := net.SplitHostPort(s)
+	return err == nil && host != "" && port != "" && (isDNSName(host) || isIP(host)) && isPort(port)
+}
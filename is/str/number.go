@@ -0,0 +1,74 @@
+package isstr
+
+import "github.com/infastin/go-validation/checkdigit"
+
+// compactNumber appends s to buf without white space and hyphens
+// and reports whether the result fits into the capacity of buf.
+func compactNumber(buf []byte, s string) ([]byte, bool) {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c != '-' && !isSpaceByte(c) {
+			if len(buf) == cap(buf) {
+				return buf, false
+			}
+			buf = append(buf, c)
+		}
+	}
+	return buf, true
+}
+
+func isCreditCard(s string) bool {
+	var buf [16]byte
+	n, ok := compactNumber(buf[:0], s)
+	return ok && isDigits(n) && isCardPrefix(n) && checkdigit.Luhn(n)
+}
+
+// isCardPrefix checks the length of a card number
+// against the ranges issued by the major networks.
+func isCardPrefix(n []byte) bool {
+	if len(n) < 4 {
+		return false
+	}
+	p := string(n[:4])
+	switch len(n) {
+	case 13:
+		return n[0] == '4'
+	case 14:
+		return p >= "3000" && p <= "3059" || n[1] == '6' && n[0] == '3' || n[1] == '8' && n[0] == '3'
+	case 15:
+		return p[:2] == "34" || p[:2] == "37" || p == "2131" || p == "1800"
+	case 16:
+		return n[0] == '4' ||
+			p >= "5100" && p <= "5599" ||
+			p >= "2221" && p <= "2720" ||
+			p == "6011" || p[:2] == "65" ||
+			p[:2] == "35" ||
+			p[:2] == "62" || p[:2] == "67"
+	default:
+		return false
+	}
+}
+
+func isISBN10(s string) bool {
+	var buf [10]byte
+	n, ok := compactNumber(buf[:0], s)
+	return ok && len(n) == 10 && checkdigit.Mod11(n)
+}
+
+func isISBN13(s string) bool {
+	var buf [13]byte
+	n, ok := compactNumber(buf[:0], s)
+	return ok && len(n) == 13 && isDigits(n) && checkdigit.Mod10(n, 3, 1)
+}
+
+func isISBN(s string) bool {
+	return isISBN10(s) || isISBN13(s)
+}
+
+func isDigits(b []byte) bool {
+	for _, c := range b {
+		if !isDigit(c) {
+			return false
+		}
+	}
+	return true
+}
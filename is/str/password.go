@@ -0,0 +1,226 @@
+package isstr
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/infastin/go-validation"
+)
+
+var (
+	ErrPasswordLower    = validation.NewRuleError("password_lower", "must contain a lowercase letter")
+	ErrPasswordUpper    = validation.NewRuleError("password_upper", "must contain an uppercase letter")
+	ErrPasswordDigit    = validation.NewRuleError("password_digit", "must contain a digit")
+	ErrPasswordSymbol   = validation.NewRuleError("password_symbol", "must contain a symbol")
+	ErrPasswordPersonal = validation.NewRuleError("password_personal", "must not be the same as the account details")
+)
+
+type PasswordPolicy struct {
+	// MinLength and MaxLength are counted in runes, zero means no limit.
+	MinLength int
+	MaxLength int
+
+	RequireLower  bool
+	RequireUpper  bool
+	RequireDigit  bool
+	RequireSymbol bool
+
+	// MaxRepeated is the longest allowed run of the same character, zero means no limit.
+	MaxRepeated int
+	// MaxSequence is the longest allowed run of consecutive letters or digits
+	// such as "abcd" or "4321", zero means no limit.
+	MaxSequence int
+
+	// MinEntropy is the minimum estimated strength in bits, computed
+	// from the length and the character classes in use.
+	MinEntropy float64
+}
+
+type passwordRule[T ~string] struct {
+	policy   PasswordPolicy
+	personal []string
+}
+
+func Password[T ~string](policy PasswordPolicy) passwordRule[T] {
+	return passwordRule[T]{
+		policy:   policy,
+		personal: nil,
+	}
+}
+
+func (r passwordRule[T]) NotEqual(values ...string) passwordRule[T] {
+	r.personal = append(r.personal[:len(r.personal):len(r.personal)], values...)
+	return r
+}
+
+func (r passwordRule[T]) Validate(v T) error {
+	var (
+		s    = string(v)
+		p    = &r.policy
+		errs []error
+	)
+
+	length := utf8.RuneCountInString(s)
+	if p.MinLength > 0 && length < p.MinLength {
+		errs = append(errs, validation.NewRuleError("password_too_short",
+			"must be at least "+strconv.Itoa(p.MinLength)+" characters long"))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		errs = append(errs, validation.NewRuleError("password_too_long",
+			"must be no more than "+strconv.Itoa(p.MaxLength)+" characters long"))
+	}
+
+	var lower, upper, digit, symbol, other bool
+	for _, c := range s {
+		switch {
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsDigit(c):
+			digit = true
+		case c < utf8.RuneSelf && unicode.IsPrint(c):
+			symbol = true
+		case unicode.IsLetter(c):
+			other = true
+		default:
+			symbol = true
+		}
+	}
+
+	if p.RequireLower && !lower {
+		errs = append(errs, ErrPasswordLower)
+	}
+	if p.RequireUpper && !upper {
+		errs = append(errs, ErrPasswordUpper)
+	}
+	if p.RequireDigit && !digit {
+		errs = append(errs, ErrPasswordDigit)
+	}
+	if p.RequireSymbol && !symbol {
+		errs = append(errs, ErrPasswordSymbol)
+	}
+
+	repeated, sequence := passwordRuns(s)
+	if p.MaxRepeated > 0 && repeated > p.MaxRepeated {
+		errs = append(errs, validation.NewRuleError("password_repeated",
+			"must not repeat a character more than "+strconv.Itoa(p.MaxRepeated)+" times in a row"))
+	}
+	if p.MaxSequence > 0 && sequence > p.MaxSequence {
+		errs = append(errs, validation.NewRuleError("password_sequence",
+			"must not contain sequences of more than "+strconv.Itoa(p.MaxSequence)+" consecutive characters"))
+	}
+
+	for _, personal := range r.personal {
+		if personal != "" && strings.EqualFold(s, personal) {
+			errs = append(errs, ErrPasswordPersonal)
+			break
+		}
+	}
+
+	if p.MinEntropy > 0 {
+		pool := 0
+		for _, class := range []struct {
+			present bool
+			size    int
+		}{
+			{lower, 26},
+			{upper, 26},
+			{digit, 10},
+			{symbol, 33},
+			{other, 100},
+		} {
+			if class.present {
+				pool += class.size
+			}
+		}
+		if pool == 0 || float64(length)*math.Log2(float64(pool)) < p.MinEntropy {
+			errs = append(errs, validation.NewRuleError("password_entropy",
+				"must have an estimated strength of at least "+strconv.FormatFloat(p.MinEntropy, 'f', -1, 64)+" bits"))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &passwordError{errs: errs}
+}
+
+// passwordRuns returns the length of the longest run of the same character
+// and of the longest ascending or descending run of letters or digits.
+func passwordRuns(s string) (repeated, sequence int) {
+	var (
+		prev      rune = -1
+		rep, seq       = 0, 0
+		direction      = 0
+	)
+	for _, c := range s {
+		c = unicode.ToLower(c)
+
+		if c == prev {
+			rep++
+		} else {
+			rep = 1
+		}
+		repeated = max(repeated, rep)
+
+		d := int(c - prev)
+		switch {
+		case prev == -1 || (d != 1 && d != -1) || !isSequenceRune(c) || !isSequenceRune(prev):
+			seq, direction = 1, 0
+		case d == direction:
+			seq++
+		default:
+			seq, direction = 2, d
+		}
+		sequence = max(sequence, seq)
+
+		prev = c
+	}
+	return repeated, sequence
+}
+
+func isSequenceRune(c rune) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z'
+}
+
+type passwordError struct {
+	errs []error
+}
+
+func (pe *passwordError) Error() string {
+	return pe.Message()
+}
+
+func (pe *passwordError) Code() string {
+	return "password_policy"
+}
+
+func (pe *passwordError) Message() string {
+	var b strings.Builder
+	for i, err := range pe.errs {
+		if i != 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+func (pe *passwordError) Params() map[string]any {
+	unmet := make([]string, len(pe.errs))
+	for i, err := range pe.errs {
+		unmet[i] = err.(validation.RuleError).Code()
+	}
+	return map[string]any{
+		"unmet": unmet,
+	}
+}
+
+func (pe *passwordError) Unwrap() []error {
+	return pe.errs
+}
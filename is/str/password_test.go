@@ -0,0 +1,75 @@
+package isstr_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_Password(t *testing.T) {
+	policy := isstr.PasswordPolicy{
+		MinLength:     10,
+		MaxLength:     64,
+		RequireLower:  true,
+		RequireUpper:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeated:   2,
+		MaxSequence:   3,
+		MinEntropy:    60,
+	}
+	tests := []struct {
+		name  string
+		value string
+		unmet []string
+	}{
+		{"strong", "Tr0ub4dor&3x", nil},
+		{"empty", "", []string{
+			"password_too_short", "password_lower", "password_upper",
+			"password_digit", "password_symbol", "password_entropy",
+		}},
+		{"short", "aB3$xy", []string{"password_too_short", "password_entropy"}},
+		{"classes", "correcthorsebattery", []string{"password_upper", "password_digit", "password_symbol"}},
+		{"repeated", "Paaassw0rd!x", []string{"password_repeated"}},
+		{"sequence", "Pass1234w!rd", []string{"password_sequence"}},
+		{"descending", "Pa$$DCBAw0rd", []string{"password_sequence"}},
+		{"personal", "J.Doe@Example.com1", []string{"password_personal"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := isstr.Password[string](policy).NotEqual("jdoe", "", "j.doe@example.com1")
+			err := r.Validate(tt.value)
+			if tt.unmet == nil {
+				if err != nil {
+					t.Errorf("Password.Validate(%q) = %v, want nil", tt.value, err)
+				}
+				return
+			}
+			var re validation.ParamsError
+			if !errors.As(err, &re) || re.Code() != "password_policy" {
+				t.Fatalf("Password.Validate(%q) = %v, want password_policy error", tt.value, err)
+			}
+			if got := re.Params()["unmet"]; !reflect.DeepEqual(got, tt.unmet) {
+				t.Errorf("Password.Validate(%q) unmet = %v, want %v", tt.value, got, tt.unmet)
+			}
+		})
+	}
+}
+
+func Test_Password_Unwrap(t *testing.T) {
+	err := isstr.Password[string](isstr.PasswordPolicy{
+		MinLength:    4,
+		RequireDigit: true,
+		RequireUpper: true,
+	}).Validate("ab")
+	if !errors.Is(err, isstr.ErrPasswordDigit) || !errors.Is(err, isstr.ErrPasswordUpper) {
+		t.Errorf("Password.Validate() = %v, want it to wrap the unmet requirements", err)
+	}
+	want := "must be at least 4 characters long; must contain an uppercase letter; must contain a digit"
+	if err.Error() != want {
+		t.Errorf("Password.Validate() = %v, want %v", err, want)
+	}
+}
@@ -0,0 +1,455 @@
+package isstr
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/infastin/go-validation"
+)
+
+var (
+	ErrE164  = validation.NewRuleError("is_e164", "must be a phone number in E.164 format")
+	ErrPhone = validation.NewRuleError("is_phone", "must be a valid phone number")
+)
+
+// E164 checks the E.164 format only: a plus sign followed by up to
+// fifteen digits without a leading zero.
+func E164[T ~string](v T) error {
+	if !isE164(string(v)) {
+		return ErrE164
+	}
+	return nil
+}
+
+type phoneRule[T ~string] struct {
+	countries  []string
+	countryErr error
+	sanitize   bool
+	region     string
+}
+
+// Phone checks an E.164 number against the calling codes and
+// the lengths of national numbers of the known countries.
+func Phone[T ~string]() phoneRule[T] {
+	return phoneRule[T]{
+		countries:  nil,
+		countryErr: nil,
+		sanitize:   false,
+		region:     "",
+	}
+}
+
+// Countries restricts numbers to the given ISO 3166 alpha-2 countries.
+func (r phoneRule[T]) Countries(countries ...string) phoneRule[T] {
+	r.countries = append(r.countries[:len(r.countries):len(r.countries)], countries...)
+	r.countryErr = buildPhoneCountryError(r.countries)
+	return r
+}
+
+// Sanitized makes the rule accept the same numbers as NormalizePhone.
+func (r phoneRule[T]) Sanitized(region string) phoneRule[T] {
+	r.sanitize = true
+	r.region = region
+	return r
+}
+
+func (r phoneRule[T]) Validate(v T) error {
+	s := string(v)
+	if r.sanitize {
+		var err error
+		if s, err = NormalizePhone(s, r.region); err != nil {
+			return err
+		}
+	} else if !isE164(s) {
+		return ErrE164
+	}
+
+	country, ok := lookupPhone(s[1:])
+	if !ok {
+		return ErrPhone
+	}
+	if r.countries != nil && !slices.Contains(r.countries, country) {
+		return r.countryErr
+	}
+
+	return nil
+}
+
+// NormalizePhone converts a phone number to the E.164 format.
+// Spaces, hyphens, dots, slashes and parentheses are removed.
+// Numbers without a leading plus sign are treated as national
+// numbers of region, which may be empty to only allow
+// international numbers.
+func NormalizePhone(s, region string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(s) + 3)
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isDigit(c):
+			b.WriteByte(c)
+		case c == '+' && b.Len() == 0 && i == strings.IndexByte(s, '+'):
+			b.WriteByte(c)
+		case strings.IndexByte(" \t-./()", c) == -1:
+			return "", ErrPhone
+		}
+	}
+
+	n := b.String()
+	if !strings.HasPrefix(n, "+") {
+		p, ok := phoneRegion(region)
+		if !ok {
+			return "", ErrPhone
+		}
+		if p.trunk != "" {
+			n = strings.TrimPrefix(n, p.trunk)
+		}
+		n = "+" + p.code + n
+	}
+
+	if !isE164(n) {
+		return "", ErrPhone
+	}
+	if _, ok := lookupPhone(n[1:]); !ok {
+		return "", ErrPhone
+	}
+	return n, nil
+}
+
+func isE164(s string) bool {
+	return len(s) >= 3 && len(s) <= 16 && s[0] == '+' && s[1] != '0' && isEvery(s[1:], isDigit)
+}
+
+func buildPhoneCountryError(countries []string) error {
+	return validation.NewRuleErrorParams("phone_country", "must be a phone number of "+strings.Join(countries, ", "), map[string]any{
+		"countries": countries,
+	})
+}
+
+type phoneMetadata struct {
+	country string
+	code    string
+	// leading lists the prefixes of national numbers of countries
+	// that share a calling code, nil means all remaining numbers.
+	leading  []string
+	min, max int
+	trunk    string
+}
+
+// lookupPhone returns the country of the digits of an E.164 number.
+func lookupPhone(digits string) (string, bool) {
+	// Calling codes form a prefix code, so at most one of them matches.
+	for n := 1; n <= 3 && n < len(digits); n++ {
+		entries, ok := phoneCodes[digits[:n]]
+		if !ok {
+			continue
+		}
+		national := digits[n:]
+		for _, p := range entries {
+			if p.leading != nil && !slices.ContainsFunc(p.leading, func(prefix string) bool {
+				return strings.HasPrefix(national, prefix)
+			}) {
+				continue
+			}
+			if len(national) < p.min || len(national) > p.max || p.code == "1" && !isNANPNumber(national) {
+				return "", false
+			}
+			return p.country, true
+		}
+		return "", false
+	}
+	return "", false
+}
+
+// isNANPNumber checks that neither the area code
+// nor the exchange code start with 0 or 1.
+func isNANPNumber(s string) bool {
+	return s[0] >= '2' && s[3] >= '2'
+}
+
+func phoneRegion(country string) (*phoneMetadata, bool) {
+	for i := range phoneMetadataTable {
+		if p := &phoneMetadataTable[i]; p.country == country {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// phoneCodes maps calling codes to the countries that use them,
+// the countries with leading digits come first.
+var phoneCodes = func() map[string][]*phoneMetadata {
+	codes := make(map[string][]*phoneMetadata)
+	for i := range phoneMetadataTable {
+		p := &phoneMetadataTable[i]
+		codes[p.code] = append(codes[p.code], p)
+	}
+	for _, entries := range codes {
+		slices.SortStableFunc(entries, func(a, b *phoneMetadata) int {
+			switch {
+			case a.leading != nil && b.leading == nil:
+				return -1
+			case a.leading == nil && b.leading != nil:
+				return 1
+			default:
+				return 0
+			}
+		})
+	}
+	return codes
+}()
+
+// phoneMetadataTable lists the calling codes and the possible lengths
+// of national significant numbers, based on the ITU-T E.164 assignments
+// and the national numbering plans.
+var phoneMetadataTable = []phoneMetadata{
+	{country: "AD", code: "376", min: 6, max: 9},
+	{country: "AE", code: "971", min: 8, max: 9, trunk: "0"},
+	{country: "AF", code: "93", min: 9, max: 9, trunk: "0"},
+	{country: "AG", code: "1", leading: []string{"268"}, min: 10, max: 10, trunk: "1"},
+	{country: "AI", code: "1", leading: []string{"264"}, min: 10, max: 10, trunk: "1"},
+	{country: "AL", code: "355", min: 8, max: 9, trunk: "0"},
+	{country: "AM", code: "374", min: 8, max: 8, trunk: "0"},
+	{country: "AO", code: "244", min: 9, max: 9},
+	{country: "AR", code: "54", min: 10, max: 11, trunk: "0"},
+	{country: "AS", code: "1", leading: []string{"684"}, min: 10, max: 10, trunk: "1"},
+	{country: "AT", code: "43", min: 4, max: 13, trunk: "0"},
+	{country: "AU", code: "61", min: 9, max: 9, trunk: "0"},
+	{country: "AW", code: "297", min: 7, max: 7},
+	{country: "AX", code: "358", leading: []string{"18"}, min: 5, max: 12, trunk: "0"},
+	{country: "AZ", code: "994", min: 9, max: 9, trunk: "0"},
+	{country: "BA", code: "387", min: 8, max: 9, trunk: "0"},
+	{country: "BB", code: "1", leading: []string{"246"}, min: 10, max: 10, trunk: "1"},
+	{country: "BD", code: "880", min: 6, max: 10, trunk: "0"},
+	{country: "BE", code: "32", min: 8, max: 9, trunk: "0"},
+	{country: "BF", code: "226", min: 8, max: 8},
+	{country: "BG", code: "359", min: 6, max: 9, trunk: "0"},
+	{country: "BH", code: "973", min: 8, max: 8},
+	{country: "BI", code: "257", min: 8, max: 8},
+	{country: "BJ", code: "229", min: 8, max: 10},
+	{country: "BL", code: "590", leading: []string{"59027"}, min: 9, max: 9, trunk: "0"},
+	{country: "BM", code: "1", leading: []string{"441"}, min: 10, max: 10, trunk: "1"},
+	{country: "BN", code: "673", min: 7, max: 7},
+	{country: "BO", code: "591", min: 8, max: 8, trunk: "0"},
+	{country: "BQ", code: "599", leading: []string{"3", "4", "7"}, min: 7, max: 7},
+	{country: "BR", code: "55", min: 10, max: 11, trunk: "0"},
+	{country: "BS", code: "1", leading: []string{"242"}, min: 10, max: 10, trunk: "1"},
+	{country: "BT", code: "975", min: 7, max: 8},
+	{country: "BW", code: "267", min: 7, max: 8},
+	{country: "BY", code: "375", min: 9, max: 9, trunk: "8"},
+	{country: "BZ", code: "501", min: 7, max: 7},
+	{country: "CA", code: "1", leading: []string{
+		"204", "226", "236", "249", "250", "257", "263", "289", "306", "343", "354", "365", "367", "368",
+		"382", "387", "403", "416", "418", "428", "431", "437", "438", "450", "460", "468", "474", "506",
+		"514", "519", "548", "579", "581", "584", "587", "600", "604", "613", "622", "639", "647", "672",
+		"683", "705", "709", "742", "753", "778", "780", "782", "807", "819", "825", "867", "873", "879",
+		"902", "905", "942",
+	}, min: 10, max: 10, trunk: "1"},
+	{country: "CC", code: "61", leading: []string{"89162"}, min: 9, max: 9, trunk: "0"},
+	{country: "CD", code: "243", min: 9, max: 9, trunk: "0"},
+	{country: "CF", code: "236", min: 8, max: 8},
+	{country: "CG", code: "242", min: 9, max: 9},
+	{country: "CH", code: "41", min: 9, max: 9, trunk: "0"},
+	{country: "CI", code: "225", min: 10, max: 10},
+	{country: "CK", code: "682", min: 5, max: 5},
+	{country: "CL", code: "56", min: 9, max: 9},
+	{country: "CM", code: "237", min: 9, max: 9},
+	{country: "CN", code: "86", min: 7, max: 12, trunk: "0"},
+	{country: "CO", code: "57", min: 8, max: 10, trunk: "0"},
+	{country: "CR", code: "506", min: 8, max: 8},
+	{country: "CU", code: "53", min: 6, max: 8, trunk: "0"},
+	{country: "CV", code: "238", min: 7, max: 7},
+	{country: "CW", code: "599", min: 7, max: 8},
+	{country: "CX", code: "61", leading: []string{"89164"}, min: 9, max: 9, trunk: "0"},
+	{country: "CY", code: "357", min: 8, max: 8},
+	{country: "CZ", code: "420", min: 9, max: 9},
+	{country: "DE", code: "49", min: 5, max: 15, trunk: "0"},
+	{country: "DJ", code: "253", min: 8, max: 8},
+	{country: "DK", code: "45", min: 8, max: 8},
+	{country: "DM", code: "1", leading: []string{"767"}, min: 10, max: 10, trunk: "1"},
+	{country: "DO", code: "1", leading: []string{"809", "829", "849"}, min: 10, max: 10, trunk: "1"},
+	{country: "DZ", code: "213", min: 8, max: 9, trunk: "0"},
+	{country: "EC", code: "593", min: 8, max: 9, trunk: "0"},
+	{country: "EE", code: "372", min: 7, max: 8},
+	{country: "EG", code: "20", min: 8, max: 10, trunk: "0"},
+	{country: "EH", code: "212", leading: []string{"5288", "5289"}, min: 9, max: 9, trunk: "0"},
+	{country: "ER", code: "291", min: 7, max: 7, trunk: "0"},
+	{country: "ES", code: "34", min: 9, max: 9},
+	{country: "ET", code: "251", min: 9, max: 9, trunk: "0"},
+	{country: "FI", code: "358", min: 5, max: 12, trunk: "0"},
+	{country: "FJ", code: "679", min: 7, max: 7},
+	{country: "FK", code: "500", min: 5, max: 5},
+	{country: "FM", code: "691", min: 7, max: 7},
+	{country: "FO", code: "298", min: 6, max: 6},
+	{country: "FR", code: "33", min: 9, max: 9, trunk: "0"},
+	{country: "GA", code: "241", min: 7, max: 8},
+	{country: "GB", code: "44", min: 7, max: 10, trunk: "0"},
+	{country: "GD", code: "1", leading: []string{"473"}, min: 10, max: 10, trunk: "1"},
+	{country: "GE", code: "995", min: 9, max: 9, trunk: "0"},
+	{country: "GF", code: "594", min: 9, max: 9, trunk: "0"},
+	{country: "GG", code: "44", leading: []string{"1481", "7781", "7839", "7911"}, min: 10, max: 10, trunk: "0"},
+	{country: "GH", code: "233", min: 9, max: 9, trunk: "0"},
+	{country: "GI", code: "350", min: 8, max: 8},
+	{country: "GL", code: "299", min: 6, max: 6},
+	{country: "GM", code: "220", min: 7, max: 7},
+	{country: "GN", code: "224", min: 8, max: 9},
+	{country: "GP", code: "590", min: 9, max: 9, trunk: "0"},
+	{country: "GQ", code: "240", min: 9, max: 9},
+	{country: "GR", code: "30", min: 10, max: 10},
+	{country: "GT", code: "502", min: 8, max: 8},
+	{country: "GU", code: "1", leading: []string{"671"}, min: 10, max: 10, trunk: "1"},
+	{country: "GW", code: "245", min: 7, max: 9},
+	{country: "GY", code: "592", min: 7, max: 7},
+	{country: "HK", code: "852", min: 8, max: 9},
+	{country: "HN", code: "504", min: 8, max: 8},
+	{country: "HR", code: "385", min: 8, max: 9, trunk: "0"},
+	{country: "HT", code: "509", min: 8, max: 8},
+	{country: "HU", code: "36", min: 8, max: 9, trunk: "06"},
+	{country: "ID", code: "62", min: 8, max: 12, trunk: "0"},
+	{country: "IE", code: "353", min: 7, max: 9, trunk: "0"},
+	{country: "IL", code: "972", min: 8, max: 9, trunk: "0"},
+	{country: "IM", code: "44", leading: []string{"1624", "7524", "7624", "7924"}, min: 10, max: 10, trunk: "0"},
+	{country: "IN", code: "91", min: 10, max: 10, trunk: "0"},
+	{country: "IO", code: "246", min: 7, max: 7},
+	{country: "IQ", code: "964", min: 8, max: 10, trunk: "0"},
+	{country: "IR", code: "98", min: 10, max: 10, trunk: "0"},
+	{country: "IS", code: "354", min: 7, max: 9},
+	{country: "IT", code: "39", min: 6, max: 11},
+	{country: "JE", code: "44", leading: []string{"1534", "7509", "7700", "7797", "7829", "7937"}, min: 10, max: 10, trunk: "0"},
+	{country: "JM", code: "1", leading: []string{"658", "876"}, min: 10, max: 10, trunk: "1"},
+	{country: "JO", code: "962", min: 8, max: 9, trunk: "0"},
+	{country: "JP", code: "81", min: 9, max: 10, trunk: "0"},
+	{country: "KE", code: "254", min: 9, max: 10, trunk: "0"},
+	{country: "KG", code: "996", min: 9, max: 9, trunk: "0"},
+	{country: "KH", code: "855", min: 8, max: 9, trunk: "0"},
+	{country: "KI", code: "686", min: 5, max: 8, trunk: "0"},
+	{country: "KM", code: "269", min: 7, max: 7},
+	{country: "KN", code: "1", leading: []string{"869"}, min: 10, max: 10, trunk: "1"},
+	{country: "KP", code: "850", min: 8, max: 10, trunk: "0"},
+	{country: "KR", code: "82", min: 8, max: 10, trunk: "0"},
+	{country: "KW", code: "965", min: 7, max: 8},
+	{country: "KY", code: "1", leading: []string{"345"}, min: 10, max: 10, trunk: "1"},
+	{country: "KZ", code: "7", leading: []string{"6", "7"}, min: 10, max: 10, trunk: "8"},
+	{country: "LA", code: "856", min: 8, max: 10, trunk: "0"},
+	{country: "LB", code: "961", min: 7, max: 8, trunk: "0"},
+	{country: "LC", code: "1", leading: []string{"758"}, min: 10, max: 10, trunk: "1"},
+	{country: "LI", code: "423", min: 7, max: 9, trunk: "0"},
+	{country: "LK", code: "94", min: 9, max: 9, trunk: "0"},
+	{country: "LR", code: "231", min: 7, max: 9, trunk: "0"},
+	{country: "LS", code: "266", min: 8, max: 8},
+	{country: "LT", code: "370", min: 8, max: 8, trunk: "8"},
+	{country: "LU", code: "352", min: 4, max: 11},
+	{country: "LV", code: "371", min: 8, max: 8},
+	{country: "LY", code: "218", min: 9, max: 9, trunk: "0"},
+	{country: "MA", code: "212", min: 9, max: 9, trunk: "0"},
+	{country: "MC", code: "377", min: 8, max: 9, trunk: "0"},
+	{country: "MD", code: "373", min: 8, max: 8, trunk: "0"},
+	{country: "ME", code: "382", min: 8, max: 9, trunk: "0"},
+	{country: "MF", code: "590", leading: []string{"59087"}, min: 9, max: 9, trunk: "0"},
+	{country: "MG", code: "261", min: 9, max: 9, trunk: "0"},
+	{country: "MH", code: "692", min: 7, max: 7, trunk: "1"},
+	{country: "MK", code: "389", min: 8, max: 8, trunk: "0"},
+	{country: "ML", code: "223", min: 8, max: 8},
+	{country: "MM", code: "95", min: 6, max: 10, trunk: "0"},
+	{country: "MN", code: "976", min: 8, max: 10, trunk: "0"},
+	{country: "MO", code: "853", min: 8, max: 8},
+	{country: "MP", code: "1", leading: []string{"670"}, min: 10, max: 10, trunk: "1"},
+	{country: "MQ", code: "596", min: 9, max: 9, trunk: "0"},
+	{country: "MR", code: "222", min: 8, max: 8},
+	{country: "MS", code: "1", leading: []string{"664"}, min: 10, max: 10, trunk: "1"},
+	{country: "MT", code: "356", min: 8, max: 8},
+	{country: "MU", code: "230", min: 7, max: 8},
+	{country: "MV", code: "960", min: 7, max: 7},
+	{country: "MW", code: "265", min: 7, max: 9, trunk: "0"},
+	{country: "MX", code: "52", min: 10, max: 10},
+	{country: "MY", code: "60", min: 8, max: 10, trunk: "0"},
+	{country: "MZ", code: "258", min: 8, max: 9},
+	{country: "NA", code: "264", min: 8, max: 10, trunk: "0"},
+	{country: "NC", code: "687", min: 6, max: 6},
+	{country: "NE", code: "227", min: 8, max: 8},
+	{country: "NF", code: "672", leading: []string{"3"}, min: 6, max: 6},
+	{country: "NG", code: "234", min: 8, max: 10, trunk: "0"},
+	{country: "NI", code: "505", min: 8, max: 8},
+	{country: "NL", code: "31", min: 9, max: 9, trunk: "0"},
+	{country: "NO", code: "47", min: 8, max: 8},
+	{country: "NP", code: "977", min: 8, max: 10, trunk: "0"},
+	{country: "NR", code: "674", min: 7, max: 7},
+	{country: "NU", code: "683", min: 4, max: 7},
+	{country: "NZ", code: "64", min: 8, max: 10, trunk: "0"},
+	{country: "OM", code: "968", min: 8, max: 8},
+	{country: "PA", code: "507", min: 7, max: 8},
+	{country: "PE", code: "51", min: 8, max: 9, trunk: "0"},
+	{country: "PF", code: "689", min: 8, max: 8},
+	{country: "PG", code: "675", min: 7, max: 8},
+	{country: "PH", code: "63", min: 8, max: 10, trunk: "0"},
+	{country: "PK", code: "92", min: 9, max: 10, trunk: "0"},
+	{country: "PL", code: "48", min: 9, max: 9},
+	{country: "PM", code: "508", min: 6, max: 6, trunk: "0"},
+	{country: "PR", code: "1", leading: []string{"787", "939"}, min: 10, max: 10, trunk: "1"},
+	{country: "PS", code: "970", min: 8, max: 9, trunk: "0"},
+	{country: "PT", code: "351", min: 9, max: 9},
+	{country: "PW", code: "680", min: 7, max: 7},
+	{country: "PY", code: "595", min: 9, max: 9, trunk: "0"},
+	{country: "QA", code: "974", min: 7, max: 8},
+	{country: "RE", code: "262", min: 9, max: 9, trunk: "0"},
+	{country: "RO", code: "40", min: 9, max: 9, trunk: "0"},
+	{country: "RS", code: "381", min: 8, max: 12, trunk: "0"},
+	{country: "RU", code: "7", min: 10, max: 10, trunk: "8"},
+	{country: "RW", code: "250", min: 9, max: 9, trunk: "0"},
+	{country: "SA", code: "966", min: 9, max: 9, trunk: "0"},
+	{country: "SB", code: "677", min: 5, max: 7},
+	{country: "SC", code: "248", min: 7, max: 7},
+	{country: "SD", code: "249", min: 9, max: 9, trunk: "0"},
+	{country: "SE", code: "46", min: 6, max: 10, trunk: "0"},
+	{country: "SG", code: "65", min: 8, max: 8},
+	{country: "SH", code: "290", min: 4, max: 5},
+	{country: "SI", code: "386", min: 8, max: 8, trunk: "0"},
+	{country: "SJ", code: "47", leading: []string{"79"}, min: 8, max: 8},
+	{country: "SK", code: "421", min: 9, max: 9, trunk: "0"},
+	{country: "SL", code: "232", min: 8, max: 8, trunk: "0"},
+	{country: "SM", code: "378", min: 6, max: 10},
+	{country: "SN", code: "221", min: 9, max: 9},
+	{country: "SO", code: "252", min: 7, max: 9, trunk: "0"},
+	{country: "SR", code: "597", min: 6, max: 7},
+	{country: "SS", code: "211", min: 9, max: 9, trunk: "0"},
+	{country: "ST", code: "239", min: 7, max: 7},
+	{country: "SV", code: "503", min: 8, max: 8},
+	{country: "SX", code: "1", leading: []string{"721"}, min: 10, max: 10, trunk: "1"},
+	{country: "SY", code: "963", min: 8, max: 9, trunk: "0"},
+	{country: "SZ", code: "268", min: 8, max: 8},
+	{country: "TC", code: "1", leading: []string{"649"}, min: 10, max: 10, trunk: "1"},
+	{country: "TD", code: "235", min: 8, max: 8},
+	{country: "TG", code: "228", min: 8, max: 8},
+	{country: "TH", code: "66", min: 8, max: 9, trunk: "0"},
+	{country: "TJ", code: "992", min: 9, max: 9},
+	{country: "TK", code: "690", min: 4, max: 7},
+	{country: "TL", code: "670", min: 7, max: 8},
+	{country: "TM", code: "993", min: 8, max: 8, trunk: "8"},
+	{country: "TN", code: "216", min: 8, max: 8},
+	{country: "TO", code: "676", min: 5, max: 7},
+	{country: "TR", code: "90", min: 10, max: 10, trunk: "0"},
+	{country: "TT", code: "1", leading: []string{"868"}, min: 10, max: 10, trunk: "1"},
+	{country: "TV", code: "688", min: 5, max: 7},
+	{country: "TW", code: "886", min: 8, max: 9, trunk: "0"},
+	{country: "TZ", code: "255", min: 9, max: 9, trunk: "0"},
+	{country: "UA", code: "380", min: 9, max: 9, trunk: "0"},
+	{country: "UG", code: "256", min: 9, max: 9, trunk: "0"},
+	{country: "US", code: "1", min: 10, max: 10, trunk: "1"},
+	{country: "UY", code: "598", min: 8, max: 8, trunk: "0"},
+	{country: "UZ", code: "998", min: 9, max: 9},
+	{country: "VA", code: "39", leading: []string{"06698"}, min: 6, max: 11},
+	{country: "VC", code: "1", leading: []string{"784"}, min: 10, max: 10, trunk: "1"},
+	{country: "VE", code: "58", min: 10, max: 10, trunk: "0"},
+	{country: "VG", code: "1", leading: []string{"284"}, min: 10, max: 10, trunk: "1"},
+	{country: "VI", code: "1", leading: []string{"340"}, min: 10, max: 10, trunk: "1"},
+	{country: "VN", code: "84", min: 9, max: 10, trunk: "0"},
+	{country: "VU", code: "678", min: 5, max: 7},
+	{country: "WF", code: "681", min: 6, max: 6},
+	{country: "WS", code: "685", min: 5, max: 7},
+	{country: "XK", code: "383", min: 8, max: 9, trunk: "0"},
+	{country: "YE", code: "967", min: 7, max: 9, trunk: "0"},
+	{country: "YT", code: "262", leading: []string{"269", "639"}, min: 9, max: 9, trunk: "0"},
+	{country: "ZA", code: "27", min: 9, max: 9, trunk: "0"},
+	{country: "ZM", code: "260", min: 9, max: 9, trunk: "0"},
+	{country: "ZW", code: "263", min: 8, max: 10, trunk: "0"},
+}
@@ -0,0 +1,134 @@
+package isstr_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_E164(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  error
+	}{
+		{"united states", "+16502530000", nil},
+		{"longest", "+123456789012345", nil},
+		{"too long", "+1234567890123456", isstr.ErrE164},
+		{"no plus", "16502530000", isstr.ErrE164},
+		{"leading zero", "+06502530000", isstr.ErrE164},
+		{"spaces", "+1 650 253 0000", isstr.ErrE164},
+		{"plus only", "+", isstr.ErrE164},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isstr.E164(tt.value); got != tt.want {
+				t.Errorf("E164() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Phone(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		countries []string
+		want      string
+	}{
+		{"united states", "+16502530000", nil, ""},
+		{"united kingdom", "+442079460000", nil, ""},
+		{"germany", "+4930123456", nil, ""},
+		{"russia", "+74951234567", nil, ""},
+		{"not e164", "8 495 123-45-67", nil, "is_e164"},
+		{"unknown calling code", "+8091234567", nil, "is_phone"},
+		{"too short", "+44207946", nil, "is_phone"},
+		{"too long", "+3361234567890", nil, "is_phone"},
+		{"nanp area code", "+11502530000", nil, "is_phone"},
+		{"nanp exchange code", "+16501530000", nil, "is_phone"},
+		{"allowed country", "+16502530000", []string{"US", "CA"}, ""},
+		{"canada", "+14165550123", []string{"CA"}, ""},
+		{"canada is not united states", "+14165550123", []string{"US"}, "phone_country"},
+		{"jamaica", "+18765550123", []string{"JM"}, ""},
+		{"kazakhstan", "+77011234567", []string{"RU"}, "phone_country"},
+		{"jersey", "+447797123456", []string{"JE"}, ""},
+		{"disallowed country", "+33612345678", []string{"DE"}, "phone_country"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := isstr.Phone[string]()
+			if tt.countries != nil {
+				rule = rule.Countries(tt.countries...)
+			}
+			var got string
+			if err := rule.Validate(tt.value); err != nil {
+				got = err.(validation.RuleError).Code()
+			}
+			if got != tt.want {
+				t.Errorf("Phone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	err := isstr.Phone[string]().Countries("DE", "AT").Validate("+33612345678").(validation.ParamsError)
+	if countries := err.Params()["countries"].([]string); !slices.Equal(countries, []string{"DE", "AT"}) {
+		t.Errorf("Phone() = %v %v, want countries DE, AT", err, err.Params())
+	}
+}
+
+func Test_Phone_Sanitized(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		region string
+		want   error
+	}{
+		{"formatted", "+1 (650) 253-0000", "", nil},
+		{"national without region", "(650) 253-0000", "", isstr.ErrPhone},
+		{"national", "(650) 253-0000", "US", nil},
+		{"letters", "+1 650 CALL NOW", "US", isstr.ErrPhone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isstr.Phone[string]().Sanitized(tt.region).Validate(tt.value); got != tt.want {
+				t.Errorf("Phone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_NormalizePhone(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		region  string
+		want    string
+		wantErr bool
+	}{
+		{"e164", "+16502530000", "", "+16502530000", false},
+		{"formatted", "+1 (650) 253-0000", "", "+16502530000", false},
+		{"dots", "+33.6.12.34.56.78", "FR", "+33612345678", false},
+		{"national trunk prefix", "06 12 34 56 78", "FR", "+33612345678", false},
+		{"nanp trunk prefix", "1-650-253-0000", "US", "+16502530000", false},
+		{"russian trunk prefix", "8 (495) 123-45-67", "RU", "+74951234567", false},
+		{"no trunk prefix", "06 6982 0000", "IT", "+390669820000", false},
+		{"no region", "06 12 34 56 78", "", "", true},
+		{"unknown region", "06 12 34 56 78", "ZZ", "", true},
+		{"plus inside", "1+6502530000", "US", "", true},
+		{"two pluses", "++16502530000", "", "", true},
+		{"invalid length", "+1 650 253 000", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isstr.NormalizePhone(tt.value, tt.region)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizePhone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizePhone() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
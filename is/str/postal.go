@@ -0,0 +1,335 @@
+package isstr
+
+import (
+	"strings"
+
+	"github.com/infastin/go-validation"
+)
+
+var (
+	ErrPostalCode        = validation.NewRuleError("is_postal_code", "must be a valid postal code")
+	ErrPostalCodeCountry = validation.NewRuleError("postal_code_country", "must be a postal code of a known country")
+	ErrNoPostalCode      = validation.NewRuleError("no_postal_code", "must be empty for a country without postal codes")
+)
+
+// PostalCode checks a postal code in the format of an ISO 3166 alpha-2 country.
+// Letters must be in upper case, countries without postal codes only accept
+// an empty string.
+func PostalCode[T ~string](country string) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		return validatePostalCode(string(v), country)
+	}
+}
+
+// PostalCodeFunc is like PostalCode, but calls country on every validation,
+// which allows to take the country from another field.
+func PostalCodeFunc[T ~string](country func() string) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		return validatePostalCode(string(v), country())
+	}
+}
+
+func validatePostalCode(s, country string) error {
+	formats, ok := postalCodeFormats[country]
+	if !ok {
+		return ErrPostalCodeCountry
+	}
+	if formats == "" {
+		if s != "" {
+			return ErrNoPostalCode
+		}
+		return nil
+	}
+	for formats != "" {
+		var format string
+		format, formats, _ = strings.Cut(formats, "|")
+		if matchFormat(s, format) {
+			return nil
+		}
+	}
+	return ErrPostalCode
+}
+
+// matchFormat matches s against a format, where n stands for digits,
+// a for letters, c for both and any other byte stands for itself.
+func matchFormat(s, format string) bool {
+	if len(s) != len(format) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; format[i] {
+		case 'n':
+			if !isDigit(c) {
+				return false
+			}
+		case 'a':
+			if !isUpperAlphaByte(c) {
+				return false
+			}
+		case 'c':
+			if !isUpperAlnumByte(c) {
+				return false
+			}
+		default:
+			if c != format[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// postalCodeFormats maps country codes to the postal code formats
+// separated with '|', based on the Universal Postal Union addressing data.
+// An empty format means that the country has no postal codes.
+var postalCodeFormats = map[string]string{
+	"AD": "ADnnn",
+	"AE": "",
+	"AF": "nnnn",
+	"AG": "",
+	"AI": "AI-2640",
+	"AL": "nnnn",
+	"AM": "nnnn",
+	"AO": "",
+	"AQ": "BIQQ 1ZZ",
+	"AR": "nnnn|annnnaaa",
+	"AS": "nnnnn|nnnnn-nnnn",
+	"AT": "nnnn",
+	"AU": "nnnn",
+	"AW": "",
+	"AX": "22nnn|AX-22nnn",
+	"AZ": "AZ nnnn|nnnn",
+	"BA": "nnnnn",
+	"BB": "BBnnnnn",
+	"BD": "nnnn",
+	"BE": "nnnn",
+	"BF": "",
+	"BG": "nnnn",
+	"BH": "nnn|nnnn",
+	"BI": "",
+	"BJ": "",
+	"BL": "97133",
+	"BM": "aa nn|aa aa",
+	"BN": "aannnn",
+	"BO": "",
+	"BQ": "",
+	"BR": "nnnnn-nnn|nnnnnnnn",
+	"BS": "",
+	"BT": "nnnnn",
+	"BV": "",
+	"BW": "",
+	"BY": "nnnnnn",
+	"BZ": "",
+	"CA": "ana nan|ananan",
+	"CC": "6799",
+	"CD": "",
+	"CF": "",
+	"CG": "",
+	"CH": "nnnn",
+	"CI": "",
+	"CK": "",
+	"CL": "nnnnnnn|nnn-nnnn",
+	"CM": "",
+	"CN": "nnnnnn",
+	"CO": "nnnnnn",
+	"CR": "nnnnn|nnnnn-nnnn",
+	"CU": "nnnnn",
+	"CV": "nnnn",
+	"CW": "",
+	"CX": "6798",
+	"CY": "nnnn",
+	"CZ": "nnn nn|nnnnn",
+	"DE": "nnnnn",
+	"DJ": "",
+	"DK": "nnnn",
+	"DM": "",
+	"DO": "nnnnn",
+	"DZ": "nnnnn",
+	"EC": "nnnnnn",
+	"EE": "nnnnn",
+	"EG": "nnnnn",
+	"EH": "nnnnn",
+	"ER": "",
+	"ES": "nnnnn",
+	"ET": "nnnn",
+	"FI": "nnnnn",
+	"FJ": "",
+	"FK": "FIQQ 1ZZ",
+	"FM": "nnnnn|nnnnn-nnnn",
+	"FO": "nnn",
+	"FR": "nnnnn",
+	"GA": "",
+	"GB": "an naa|ann naa|aan naa|aann naa|ana naa|aana naa|GIR 0AA",
+	"GD": "",
+	"GE": "nnnn",
+	"GF": "973nn",
+	"GG": "GYn naa|GYnn naa",
+	"GH": "",
+	"GI": "GX11 1AA",
+	"GL": "39nn",
+	"GM": "",
+	"GN": "nnn",
+	"GP": "971nn",
+	"GQ": "",
+	"GR": "nnn nn|nnnnn",
+	"GS": "SIQQ 1ZZ",
+	"GT": "nnnnn",
+	"GU": "nnnnn|nnnnn-nnnn",
+	"GW": "nnnn",
+	"GY": "",
+	"HK": "",
+	"HM": "nnnn",
+	"HN": "nnnnn|aannnn",
+	"HR": "nnnnn",
+	"HT": "nnnn",
+	"HU": "nnnn",
+	"ID": "nnnnn",
+	"IE": "anc cccc|anccccc",
+	"IL": "nnnnnnn",
+	"IM": "IMn naa|IMnn naa",
+	"IN": "nnnnnn|nnn nnn",
+	"IO": "BBND 1ZZ",
+	"IQ": "nnnnn",
+	"IR": "nnnnnnnnnn|nnnnn-nnnnn",
+	"IS": "nnn",
+	"IT": "nnnnn",
+	"JE": "JEn naa|JEnn naa",
+	"JM": "",
+	"JO": "nnnnn",
+	"JP": "nnn-nnnn|nnnnnnn",
+	"KE": "nnnnn",
+	"KG": "nnnnnn",
+	"KH": "nnnnn|nnnnnn",
+	"KI": "",
+	"KM": "",
+	"KN": "",
+	"KP": "",
+	"KR": "nnnnn",
+	"KW": "nnnnn",
+	"KY": "KYn-nnnn",
+	"KZ": "nnnnnn",
+	"LA": "nnnnn",
+	"LB": "nnnn|nnnn nnnn",
+	"LC": "LCnn nnn",
+	"LI": "94nn",
+	"LK": "nnnnn",
+	"LR": "nnnn",
+	"LS": "nnn",
+	"LT": "nnnnn|LT-nnnnn",
+	"LU": "nnnn|L-nnnn",
+	"LV": "LV-nnnn",
+	"LY": "",
+	"MA": "nnnnn",
+	"MC": "980nn",
+	"MD": "nnnn|MD-nnnn",
+	"ME": "nnnnn",
+	"MF": "97150",
+	"MG": "nnn",
+	"MH": "nnnnn|nnnnn-nnnn",
+	"MK": "nnnn",
+	"ML": "",
+	"MM": "nnnnn",
+	"MN": "nnnnn",
+	"MO": "",
+	"MP": "nnnnn|nnnnn-nnnn",
+	"MQ": "972nn",
+	"MR": "",
+	"MS": "MSR nnnn",
+	"MT": "aaa nnnn|aa nnnn",
+	"MU": "nnnnn",
+	"MV": "nnnnn",
+	"MW": "",
+	"MX": "nnnnn",
+	"MY": "nnnnn",
+	"MZ": "nnnn",
+	"NA": "nnnnn",
+	"NC": "988nn",
+	"NE": "nnnn",
+	"NF": "2899",
+	"NG": "nnnnnn",
+	"NI": "nnnnn",
+	"NL": "nnnn aa|nnnnaa",
+	"NO": "nnnn",
+	"NP": "nnnnn",
+	"NR": "",
+	"NU": "",
+	"NZ": "nnnn",
+	"OM": "nnn",
+	"PA": "nnnn",
+	"PE": "nnnnn|aaaa nnnn",
+	"PF": "987nn",
+	"PG": "nnn",
+	"PH": "nnnn",
+	"PK": "nnnnn",
+	"PL": "nn-nnn",
+	"PM": "97500",
+	"PN": "PCRN 1ZZ",
+	"PR": "nnnnn|nnnnn-nnnn",
+	"PS": "nnn",
+	"PT": "nnnn-nnn",
+	"PW": "nnnnn|nnnnn-nnnn",
+	"PY": "nnnn",
+	"QA": "",
+	"RE": "974nn",
+	"RO": "nnnnnn",
+	"RS": "nnnnn",
+	"RU": "nnnnnn",
+	"RW": "",
+	"SA": "nnnnn|nnnnn-nnnn",
+	"SB": "",
+	"SC": "",
+	"SD": "nnnnn",
+	"SE": "nnn nn|nnnnn",
+	"SG": "nnnnnn",
+	"SH": "ASCN 1ZZ|STHL 1ZZ|TDCU 1ZZ",
+	"SI": "nnnn|SI-nnnn",
+	"SJ": "nnnn",
+	"SK": "nnn nn|nnnnn",
+	"SL": "",
+	"SM": "4789n",
+	"SN": "nnnnn",
+	"SO": "aa nnnnn",
+	"SR": "",
+	"SS": "",
+	"ST": "",
+	"SV": "CP nnnn",
+	"SX": "",
+	"SY": "",
+	"SZ": "annn",
+	"TC": "TKCA 1ZZ",
+	"TD": "",
+	"TF": "",
+	"TG": "",
+	"TH": "nnnnn",
+	"TJ": "nnnnnn",
+	"TK": "",
+	"TL": "",
+	"TM": "nnnnnn",
+	"TN": "nnnn",
+	"TO": "",
+	"TR": "nnnnn",
+	"TT": "nnnnnn",
+	"TV": "",
+	"TW": "nnn|nnnnn|nnnnnn",
+	"TZ": "nnnnn",
+	"UA": "nnnnn",
+	"UG": "",
+	"UM": "96898",
+	"US": "nnnnn|nnnnn-nnnn",
+	"UY": "nnnnn",
+	"UZ": "nnnnnn",
+	"VA": "00120",
+	"VC": "VCnnnn",
+	"VE": "nnnn|nnnn-a",
+	"VG": "VG11n0",
+	"VI": "nnnnn|nnnnn-nnnn",
+	"VN": "nnnnnn",
+	"VU": "",
+	"WF": "986nn",
+	"WS": "",
+	"YE": "",
+	"YT": "976nn",
+	"ZA": "nnnn",
+	"ZM": "nnnnn",
+	"ZW": "",
+}
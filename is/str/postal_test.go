@@ -0,0 +1,62 @@
+package isstr_test
+
+import (
+	"testing"
+
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_PostalCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		country string
+		value   string
+		want    error
+	}{
+		{"united states", "US", "94043", nil},
+		{"united states zip+4", "US", "94043-1351", nil},
+		{"united kingdom", "GB", "SW1A 1AA", nil},
+		{"united kingdom short", "GB", "M1 1AE", nil},
+		{"canada", "CA", "K1A 0B1", nil},
+		{"netherlands", "NL", "1012 AB", nil},
+		{"poland", "PL", "00-950", nil},
+		{"andorra", "AD", "AD500", nil},
+		{"no postal codes", "AE", "", nil},
+		{"united states too short", "US", "9404", isstr.ErrPostalCode},
+		{"united kingdom lower case", "GB", "sw1a 1aa", isstr.ErrPostalCode},
+		{"canada digits", "CA", "111 111", isstr.ErrPostalCode},
+		{"poland without hyphen", "PL", "00950", isstr.ErrPostalCode},
+		{"empty", "DE", "", isstr.ErrPostalCode},
+		{"postal code for country without them", "AE", "12345", isstr.ErrNoPostalCode},
+		{"unknown country", "ZZ", "12345", isstr.ErrPostalCodeCountry},
+		{"lower case country", "us", "94043", isstr.ErrPostalCodeCountry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isstr.PostalCode[string](tt.country).Validate(tt.value); got != tt.want {
+				t.Errorf("PostalCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_PostalCodeFunc(t *testing.T) {
+	address := struct {
+		Country    string
+		PostalCode string
+	}{}
+
+	rule := isstr.PostalCodeFunc[string](func() string {
+		return address.Country
+	})
+
+	address.Country, address.PostalCode = "DE", "10115"
+	if got := rule.Validate(address.PostalCode); got != nil {
+		t.Errorf("PostalCodeFunc() = %v, want %v", got, nil)
+	}
+
+	address.Country = "PT"
+	if got := rule.Validate(address.PostalCode); got != isstr.ErrPostalCode {
+		t.Errorf("PostalCodeFunc() = %v, want %v", got, isstr.ErrPostalCode)
+	}
+}
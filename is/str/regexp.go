@@ -0,0 +1,71 @@
+package isstr
+
+import (
+	"regexp"
+	"sync"
+	"sync/atomic"
+
+	"github.com/infastin/go-validation"
+)
+
+// patternCache holds the patterns compiled by MatchPattern and NotMatchPattern.
+// Patterns are expected to be constants, but to keep dynamic patterns from
+// growing it without bound, patterns past patternCacheSize are compiled
+// on every call instead.
+const patternCacheSize = 1024
+
+var (
+	patternCache    sync.Map
+	patternCacheLen atomic.Int64
+)
+
+func Match[T ~string](re *regexp.Regexp, code, message string) validation.StringRuleFunc[T] {
+	err := buildPatternError(re, code, message)
+	return func(v T) error {
+		if !re.MatchString(string(v)) {
+			return err
+		}
+		return nil
+	}
+}
+
+func NotMatch[T ~string](re *regexp.Regexp, code, message string) validation.StringRuleFunc[T] {
+	err := buildPatternError(re, code, message)
+	return func(v T) error {
+		if re.MatchString(string(v)) {
+			return err
+		}
+		return nil
+	}
+}
+
+func MatchPattern[T ~string](pattern, code, message string) validation.StringRuleFunc[T] {
+	return Match[T](compilePattern(pattern), code, message)
+}
+
+func NotMatchPattern[T ~string](pattern, code, message string) validation.StringRuleFunc[T] {
+	return NotMatch[T](compilePattern(pattern), code, message)
+}
+
+// compilePattern compiles the pattern once per process.
+// Like regexp.MustCompile, it panics if the pattern is invalid.
+func compilePattern(pattern string) *regexp.Regexp {
+	if re, ok := patternCache.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(pattern)
+	if patternCacheLen.Load() >= patternCacheSize {
+		return re
+	}
+	cached, loaded := patternCache.LoadOrStore(pattern, re)
+	if !loaded {
+		patternCacheLen.Add(1)
+	}
+	return cached.(*regexp.Regexp)
+}
+
+func buildPatternError(re *regexp.Regexp, code, message string) error {
+	return validation.NewRuleErrorParams(code, message, map[string]any{
+		"pattern": re.String(),
+	})
+}
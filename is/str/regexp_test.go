@@ -0,0 +1,62 @@
+package isstr_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_Match(t *testing.T) {
+	re := regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+	tests := []struct {
+		name  string
+		rule  validation.StringRuleFunc[string]
+		value string
+		want  string
+	}{
+		{"match", isstr.Match[string](re, "slug", "must be a valid slug"), "my-slug-1", ""},
+		{"no match", isstr.Match[string](re, "slug", "must be a valid slug"), "My Slug", "slug"},
+		{"not match", isstr.NotMatch[string](re, "not_slug", "must not be a slug"), "My Slug", ""},
+		{"not match fails", isstr.NotMatch[string](re, "not_slug", "must not be a slug"), "my-slug", "not_slug"},
+		{"pattern", isstr.MatchPattern[string](`^\d{4}$`, "pin", "must be a 4-digit PIN"), "1234", ""},
+		{"pattern no match", isstr.MatchPattern[string](`^\d{4}$`, "pin", "must be a 4-digit PIN"), "12345", "pin"},
+		{"not pattern", isstr.NotMatchPattern[string](`\s`, "no_spaces", "must not contain spaces"), "a b", "no_spaces"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.StringV[string]().By(tt.rule).Validate(tt.value)
+			var got string
+			if err != nil {
+				got = err.(validation.RuleError).Code()
+			}
+			if got != tt.want {
+				t.Errorf("Validate(%q) = %v, want %v", tt.value, err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Match_Params(t *testing.T) {
+	err := isstr.MatchPattern[string](`^\d{4}$`, "pin", "must be a 4-digit PIN")("abc")
+	re, ok := err.(validation.ParamsError)
+	if !ok {
+		t.Fatalf("MatchPattern() = %v, want ParamsError", err)
+	}
+	if got := re.Params()["pattern"]; got != `^\d{4}$` {
+		t.Errorf("Params()[pattern] = %v, want %v", got, `^\d{4}$`)
+	}
+	if got := re.Message(); got != "must be a 4-digit PIN" {
+		t.Errorf("Message() = %v, want %v", got, "must be a 4-digit PIN")
+	}
+}
+
+func Test_MatchPattern_Invalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MatchPattern() did not panic on an invalid pattern")
+		}
+	}()
+	isstr.MatchPattern[string]("(", "code", "message")
+}
@@ -0,0 +1,389 @@
+package isstr
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/infastin/go-validation"
+)
+
+var ErrRRule = validation.NewRuleError("is_rrule", "must be a valid recurrence rule")
+
+func RRule[T ~string](v T) error {
+	if _, ok := parseRRule(string(v)); !ok {
+		return ErrRRule
+	}
+	return nil
+}
+
+func RRuleMinInterval[T ~string](d time.Duration) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		r, ok := parseRRule(string(v))
+		if !ok {
+			return ErrRRule
+		}
+		if r.minInterval() < d {
+			return buildMinIntervalError("rrule_min_interval", d)
+		}
+		return nil
+	}
+}
+
+type rruleFreq int
+
+const (
+	rruleSecondly rruleFreq = iota
+	rruleMinutely
+	rruleHourly
+	rruleDaily
+	rruleWeekly
+	rruleMonthly
+	rruleYearly
+)
+
+var rruleFreqs = map[string]rruleFreq{
+	"SECONDLY": rruleSecondly,
+	"MINUTELY": rruleMinutely,
+	"HOURLY":   rruleHourly,
+	"DAILY":    rruleDaily,
+	"WEEKLY":   rruleWeekly,
+	"MONTHLY":  rruleMonthly,
+	"YEARLY":   rruleYearly,
+}
+
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+type rruleWeekday struct {
+	n       int
+	weekday int
+}
+
+type rrule struct {
+	freq       rruleFreq
+	interval   int
+	count      int
+	bySecond   []int
+	byMinute   []int
+	byHour     []int
+	byDay      []rruleWeekday
+	byMonthDay []int
+	byYearDay  []int
+	byWeekNo   []int
+	byMonth    []int
+	bySetPos   []int
+	wkst       int
+}
+
+func parseRRule(s string) (r rrule, ok bool) {
+	if len(s) >= 6 && strings.EqualFold(s[:6], "RRULE:") {
+		s = s[6:]
+	}
+
+	r.freq = -1
+	r.interval = 1
+	r.wkst = int(time.Monday)
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return r, false
+		}
+
+		name = strings.ToUpper(name)
+		if seen[name] {
+			return r, false
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			f, found := rruleFreqs[strings.ToUpper(value)]
+			if !found {
+				return r, false
+			}
+			r.freq = f
+		case "UNTIL":
+			if !isRRuleUntil(value) {
+				return r, false
+			}
+		case "COUNT":
+			if r.count, ok = parseRRuleInt(value, 1, math.MaxInt32, false); !ok {
+				return r, false
+			}
+		case "INTERVAL":
+			if r.interval, ok = parseRRuleInt(value, 1, math.MaxInt32, false); !ok {
+				return r, false
+			}
+		case "BYSECOND":
+			if r.bySecond, ok = parseRRuleList(value, 0, 60, false); !ok {
+				return r, false
+			}
+		case "BYMINUTE":
+			if r.byMinute, ok = parseRRuleList(value, 0, 59, false); !ok {
+				return r, false
+			}
+		case "BYHOUR":
+			if r.byHour, ok = parseRRuleList(value, 0, 23, false); !ok {
+				return r, false
+			}
+		case "BYDAY":
+			for _, item := range strings.Split(value, ",") {
+				wd, ok := parseRRuleWeekday(item)
+				if !ok {
+					return r, false
+				}
+				r.byDay = append(r.byDay, wd)
+			}
+		case "BYMONTHDAY":
+			if r.byMonthDay, ok = parseRRuleList(value, 1, 31, true); !ok {
+				return r, false
+			}
+		case "BYYEARDAY":
+			if r.byYearDay, ok = parseRRuleList(value, 1, 366, true); !ok {
+				return r, false
+			}
+		case "BYWEEKNO":
+			if r.byWeekNo, ok = parseRRuleList(value, 1, 53, true); !ok {
+				return r, false
+			}
+		case "BYMONTH":
+			if r.byMonth, ok = parseRRuleList(value, 1, 12, false); !ok {
+				return r, false
+			}
+		case "BYSETPOS":
+			if r.bySetPos, ok = parseRRuleList(value, 1, 366, true); !ok {
+				return r, false
+			}
+		case "WKST":
+			if r.wkst = slices.Index(rruleWeekdays, strings.ToUpper(value)); r.wkst == -1 {
+				return r, false
+			}
+		default:
+			return r, false
+		}
+	}
+
+	switch {
+	case r.freq == -1:
+		return r, false
+	case seen["COUNT"] && seen["UNTIL"]:
+		return r, false
+	case r.byWeekNo != nil && r.freq != rruleYearly:
+		return r, false
+	case r.byYearDay != nil && (r.freq == rruleDaily || r.freq == rruleWeekly || r.freq == rruleMonthly):
+		return r, false
+	case r.byMonthDay != nil && r.freq == rruleWeekly:
+		return r, false
+	case r.bySetPos != nil && r.bySecond == nil && r.byMinute == nil && r.byHour == nil && r.byDay == nil &&
+		r.byMonthDay == nil && r.byYearDay == nil && r.byWeekNo == nil && r.byMonth == nil:
+		return r, false
+	}
+
+	for _, wd := range r.byDay {
+		if wd.n == 0 {
+			continue
+		}
+		if r.freq != rruleMonthly && r.freq != rruleYearly {
+			return r, false
+		}
+		if r.freq == rruleYearly && r.byWeekNo != nil {
+			return r, false
+		}
+	}
+
+	return r, true
+}
+
+func isRRuleUntil(s string) bool {
+	for _, layout := range []string{"20060102", "20060102T150405", "20060102T150405Z"} {
+		if len(s) != len(layout) {
+			continue
+		}
+		if _, err := time.Parse(layout, s); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func parseRRuleInt(s string, lo, hi int, signed bool) (int, bool) {
+	neg := false
+	if signed && s != "" && (s[0] == '+' || s[0] == '-') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "" || len(s) > 10 {
+		return 0, false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return 0, false
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < lo || n > hi {
+		return 0, false
+	}
+	if neg {
+		n = -n
+	}
+	return n, true
+}
+
+func parseRRuleList(s string, lo, hi int, signed bool) ([]int, bool) {
+	var list []int
+	for _, item := range strings.Split(s, ",") {
+		n, ok := parseRRuleInt(item, lo, hi, signed)
+		if !ok {
+			return nil, false
+		}
+		list = append(list, n)
+	}
+	return list, true
+}
+
+func parseRRuleWeekday(s string) (rruleWeekday, bool) {
+	if len(s) < 2 {
+		return rruleWeekday{}, false
+	}
+	wd := slices.Index(rruleWeekdays, strings.ToUpper(s[len(s)-2:]))
+	if wd == -1 {
+		return rruleWeekday{}, false
+	}
+	if len(s) == 2 {
+		return rruleWeekday{n: 0, weekday: wd}, true
+	}
+	n, ok := parseRRuleInt(s[:len(s)-2], 1, 53, true)
+	if !ok {
+		return rruleWeekday{}, false
+	}
+	return rruleWeekday{n: n, weekday: wd}, true
+}
+
+// minInterval returns a lower bound of the time between two consecutive occurrences.
+// The start of the recurrence set is unknown, so the bound assumes the most
+// unfavorable alignment and treats BYxxx parts that it cannot resolve without
+// a calendar as if they allowed consecutive days.
+func (r *rrule) minInterval() time.Duration {
+	if r.count == 1 {
+		return math.MaxInt64
+	}
+
+	const day = 24 * 60 * 60
+
+	if r.freq >= rruleDaily {
+		first, last, gap := r.timeOfDayInterval(rruleSecondly, rruleHourly)
+		return time.Duration(minGap(gap, r.minDayInterval()*day-(last-first))) * time.Second
+	}
+
+	// Occurrences within one period of the frequency are expanded
+	// by the parts finer than the frequency.
+	inner, span := -1, 0
+	if r.freq > rruleSecondly {
+		first, last, gap := r.timeOfDayInterval(rruleSecondly, r.freq-1)
+		inner, span = gap, last-first
+	}
+
+	// Periods with occurrences lie on the grid of the frequency, which runs
+	// on across hours and days, and are also limited by the remaining parts.
+	// Either bound holds for the distance between two such periods.
+	first, last, gap := r.timeOfDayInterval(r.freq, rruleHourly)
+	period := max(minGap(gap, day-(last-first)), r.interval*[...]int{1, 60, 3600}[r.freq])
+
+	return time.Duration(minGap(inner, period-span)) * time.Second
+}
+
+// timeOfDayInterval combines the values of the time of day parts from lo to hi
+// the way cron does, and returns the first and the last offset in seconds
+// along with the smallest gap between two offsets, or -1 if there is one offset.
+// Parts finer than the frequency default to the value of DTSTART,
+// other parts default to any value.
+func (r *rrule) timeOfDayInterval(lo, hi rruleFreq) (first, last, gap int) {
+	var (
+		by    = [...][]int{r.bySecond, r.byMinute, r.byHour}
+		sizes = [...]int{60, 60, 24}
+		units = [...]int{1, 60, 3600}
+	)
+
+	gap = -1
+	for f := lo; f <= hi; f++ {
+		var set uint64
+		switch {
+		case by[f] != nil:
+			for _, v := range by[f] {
+				set |= 1 << v
+			}
+		case f < r.freq:
+			set = 1
+		default:
+			set = 1<<sizes[f] - 1
+		}
+
+		vFirst, vLast, vGap := bitsetSpan(set)
+		if vGap != -1 {
+			gap = minGap(gap, vGap*units[f]-(last-first))
+		}
+		first, last = vFirst*units[f]+first, vLast*units[f]+last
+	}
+	return first, last, gap
+}
+
+// minDayInterval returns a lower bound of the number of days
+// between two consecutive days with occurrences.
+func (r *rrule) minDayInterval() int {
+	switch r.freq {
+	case rruleDaily:
+		return r.interval
+	case rruleWeekly:
+		if r.byDay == nil {
+			return 7 * r.interval
+		}
+		days := make([]int, len(r.byDay))
+		for i, wd := range r.byDay {
+			days[i] = (wd.weekday - r.wkst + 7) % 7
+		}
+		return cyclicInterval(days, 7*r.interval)
+	case rruleMonthly:
+		if r.byDay == nil && r.byMonthDay == nil {
+			return 28 * r.interval
+		}
+	case rruleYearly:
+		if r.byDay == nil && r.byMonthDay == nil && r.byYearDay == nil && r.byWeekNo == nil {
+			if len(r.byMonth) <= 1 {
+				return 365 * r.interval
+			}
+			return 28 * cyclicInterval(r.byMonth, 12*r.interval)
+		}
+	}
+
+	// Occurrences are a subset of the days matched by each part,
+	// so the largest of the individual bounds still holds.
+	res := 1
+	if r.byDay != nil && !slices.ContainsFunc(r.byDay, func(wd rruleWeekday) bool { return wd.n != 0 }) {
+		days := make([]int, len(r.byDay))
+		for i, wd := range r.byDay {
+			days[i] = wd.weekday
+		}
+		res = max(res, cyclicInterval(days, 7))
+	}
+	if r.freq == rruleMonthly && r.byMonthDay != nil && !slices.ContainsFunc(r.byMonthDay, func(d int) bool { return d < 0 }) {
+		res = max(res, cyclicInterval(r.byMonthDay, 28*r.interval))
+	}
+	return res
+}
+
+func cyclicInterval(values []int, period int) int {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	res := period - (values[len(values)-1] - values[0])
+	for i := 1; i < len(values); i++ {
+		res = min(res, values[i]-values[i-1])
+	}
+	return res
+}
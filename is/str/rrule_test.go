@@ -0,0 +1,104 @@
+package isstr_test
+
+import (
+	"testing"
+	"time"
+
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_RRule(t *testing.T) {
+	tests := []struct {
+		value string
+		want  error
+	}{
+		{"FREQ=DAILY", nil},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE,FR;WKST=SU", nil},
+		{"FREQ=MONTHLY;BYDAY=-1FR;COUNT=10", nil},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", nil},
+		{"FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=1;UNTIL=20301231T235959Z", nil},
+		{"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO", nil},
+		{"FREQ=YEARLY;BYYEARDAY=1,100,-1;UNTIL=20301231", nil},
+		{"freq=hourly;byminute=0,30", nil},
+		{"", isstr.ErrRRule},
+		{"INTERVAL=2", isstr.ErrRRule},
+		{"FREQ=FORTNIGHTLY", isstr.ErrRRule},
+		{"FREQ=DAILY;COUNT=5;UNTIL=20300101", isstr.ErrRRule},
+		{"FREQ=DAILY;FREQ=WEEKLY", isstr.ErrRRule},
+		{"FREQ=DAILY;INTERVAL=0", isstr.ErrRRule},
+		{"FREQ=DAILY;COUNT=-1", isstr.ErrRRule},
+		{"FREQ=DAILY;BYHOUR=24", isstr.ErrRRule},
+		{"FREQ=DAILY;BYMONTHDAY=0", isstr.ErrRRule},
+		{"FREQ=WEEKLY;BYDAY=1MO", isstr.ErrRRule},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", isstr.ErrRRule},
+		{"FREQ=MONTHLY;BYWEEKNO=1", isstr.ErrRRule},
+		{"FREQ=MONTHLY;BYYEARDAY=1", isstr.ErrRRule},
+		{"FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO", isstr.ErrRRule},
+		{"FREQ=DAILY;BYSETPOS=1", isstr.ErrRRule},
+		{"FREQ=DAILY;UNTIL=2030-01-01", isstr.ErrRRule},
+		{"FREQ=DAILY;X-NAME=1", isstr.ErrRRule},
+		{"FREQ=DAILY;", isstr.ErrRRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := isstr.RRule(tt.value); got != tt.want {
+				t.Errorf("RRule(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_RRuleMinInterval(t *testing.T) {
+	tests := []struct {
+		value    string
+		interval time.Duration
+		wantErr  bool
+	}{
+		{"FREQ=MINUTELY;INTERVAL=15", 15 * time.Minute, false},
+		{"FREQ=MINUTELY;INTERVAL=15", 20 * time.Minute, true},
+		{"FREQ=MINUTELY;BYSECOND=0,30", 30 * time.Second, false},
+		{"FREQ=MINUTELY;BYSECOND=0,30", time.Minute, true},
+		{"FREQ=HOURLY;BYHOUR=9,17", 8 * time.Hour, false},
+		{"FREQ=HOURLY;BYHOUR=9,17", 9 * time.Hour, true},
+		{"FREQ=DAILY;BYHOUR=9,12", 3 * time.Hour, false},
+		{"FREQ=DAILY;BYHOUR=9,12", 4 * time.Hour, true},
+		{"FREQ=DAILY;BYHOUR=0,23", 2 * time.Hour, true},
+		{"FREQ=DAILY;INTERVAL=2;BYHOUR=0,23", 23 * time.Hour, false},
+		{"FREQ=WEEKLY;BYDAY=MO,TH", 72 * time.Hour, false},
+		{"FREQ=WEEKLY;BYDAY=MO,TH", 96 * time.Hour, true},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,15", 13 * 24 * time.Hour, false},
+		{"FREQ=MONTHLY;BYMONTHDAY=1,-1", 2 * 24 * time.Hour, true},
+		{"FREQ=MONTHLY;BYDAY=FR", 7 * 24 * time.Hour, false},
+		{"FREQ=YEARLY", 365 * 24 * time.Hour, false},
+		{"FREQ=YEARLY;BYMONTH=1,7", 6 * 28 * 24 * time.Hour, false},
+		{"FREQ=SECONDLY;COUNT=1", 24 * time.Hour, false},
+		{"FREQ=SECONDLY", 2 * time.Second, true},
+		{"FREQ=MINUTELY;INTERVAL=7;BYSECOND=0", 5 * time.Minute, false},
+		{"FREQ=MINUTELY;INTERVAL=7;BYSECOND=0", 8 * time.Minute, true},
+		{"FREQ=HOURLY;INTERVAL=5;BYMINUTE=0", 4*time.Hour + 30*time.Minute, false},
+		{"FREQ=HOURLY;INTERVAL=5;BYMINUTE=0", 6 * time.Hour, true},
+		{"FREQ=HOURLY;BYMINUTE=0,59", time.Minute, false},
+		{"FREQ=HOURLY;BYMINUTE=0,59", 2 * time.Minute, true},
+		{"FREQ=SECONDLY;BYMINUTE=0;BYSECOND=0", time.Hour, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := isstr.RRuleMinInterval[string](tt.interval).Validate(tt.value)
+			if (got != nil) != tt.wantErr {
+				t.Errorf("RRuleMinInterval(%v).Validate(%q) = %v, wantErr %v", tt.interval, tt.value, got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Benchmark_RRuleMinInterval(b *testing.B) {
+	rule := isstr.RRuleMinInterval[string](time.Hour)
+	for _, rrule := range []string{"FREQ=SECONDLY", "FREQ=HOURLY;BYMINUTE=0,15,30,45", "FREQ=WEEKLY;BYDAY=MO,TH;BYHOUR=9,17"} {
+		b.Run(rrule, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				_ = rule(rrule)
+			}
+		})
+	}
+}
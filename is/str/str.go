@@ -2,9 +2,7 @@ package isstr
 
 import (
 	"os"
-	"regexp"
 
-	"github.com/asaskevich/govalidator"
 	"github.com/infastin/go-validation"
 )
 
@@ -60,320 +58,319 @@ var (
 	ErrDirectory      = validation.NewRuleError("is_directory", "must be a valid path to a directory")
 )
 
-var (
-	rxUUID7 = regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
-)
-
 func HexColor[T ~string](v T) error {
-	if !govalidator.IsHexcolor(string(v)) {
+	if !isHexColor(string(v)) {
 		return ErrHexColor
 	}
 	return nil
 }
 
 func RGBColor[T ~string](v T) error {
-	if !govalidator.IsRGBcolor(string(v)) {
+	if !isRGBColor(string(v)) {
 		return ErrRGBColor
 	}
 	return nil
 }
 
 func LowerCase[T ~string](v T) error {
-	if !govalidator.IsLowerCase(string(v)) {
+	if !isLowerCase(string(v)) {
 		return ErrLowerCase
 	}
 	return nil
 }
 
 func Alpha[T ~string](v T) error {
-	if !govalidator.IsAlpha(string(v)) {
+	if !isAlpha(string(v)) {
 		return ErrAlpha
 	}
 	return nil
 }
 
 func Numeric[T ~string](v T) error {
-	if !govalidator.IsNumeric(string(v)) {
+	if !isNumeric(string(v)) {
 		return ErrNumeric
 	}
 	return nil
 }
 
 func Alphanumeric[T ~string](v T) error {
-	if !govalidator.IsAlphanumeric(string(v)) {
+	if !isAlphanumeric(string(v)) {
 		return ErrAlphanumeric
 	}
 	return nil
 }
 
 func ASCII[T ~string](v T) error {
-	if !govalidator.IsASCII(string(v)) {
+	if !isASCII(string(v)) {
 		return ErrASCII
 	}
 	return nil
 }
 
 func PrintableASCII[T ~string](v T) error {
-	if !govalidator.IsPrintableASCII(string(v)) {
+	if !isPrintableASCII(string(v)) {
 		return ErrPrintableASCII
 	}
 	return nil
 }
 
 func UpperCase[T ~string](v T) error {
-	if !govalidator.IsUpperCase(string(v)) {
+	if !isUpperCase(string(v)) {
 		return ErrUpperCase
 	}
 	return nil
 }
 
 func Email[T ~string](v T) error {
-	if !govalidator.IsEmail(string(v)) {
+	if !isEmail(string(v)) {
 		return ErrEmail
 	}
 	return nil
 }
 
+// ExistingEmail performs the syntax checks of govalidator's IsExistingEmail,
+// but not its DNS lookups, so it never touches the network. To check that
+// the domain accepts mail, resolve it with net.LookupMX in a custom rule.
 func ExistingEmail[T ~string](v T) error {
-	if !govalidator.IsExistingEmail(string(v)) {
+	if !isExistingEmail(string(v)) {
 		return ErrEmail
 	}
 	return nil
 }
 
 func URL[T ~string](v T) error {
-	if !govalidator.IsURL(string(v)) {
+	if !isURL(string(v)) {
 		return ErrURL
 	}
 	return nil
 }
 
 func UUID[T ~string](v T) error {
-	if !govalidator.IsUUID(string(v)) {
+	if !isUUID(string(v), 0, false) {
 		return ErrUUID
 	}
 	return nil
 }
 
 func UUIDv3[T ~string](v T) error {
-	if !govalidator.IsUUIDv3(string(v)) {
+	if !isUUID(string(v), '3', false) {
 		return ErrUUIDv3
 	}
 	return nil
 }
 
 func UUIDv4[T ~string](v T) error {
-	if !govalidator.IsUUIDv4(string(v)) {
+	if !isUUID(string(v), '4', true) {
 		return ErrUUIDv4
 	}
 	return nil
 }
 
 func UUIDv5[T ~string](v T) error {
-	if !govalidator.IsUUIDv5(string(v)) {
+	if !isUUID(string(v), '5', true) {
 		return ErrUUIDv5
 	}
 	return nil
 }
 
 func UUIDv7[T ~string](v T) error {
-	if !rxUUID7.MatchString(string(v)) {
+	if !isUUID(string(v), '7', true) {
 		return ErrUUIDv7
 	}
 	return nil
 }
 
 func ULID[T ~string](v T) error {
-	if !govalidator.IsULID(string(v)) {
+	if !isULID(string(v)) {
 		return ErrULID
 	}
 	return nil
 }
 
 func JSON[T ~string](v T) error {
-	if !govalidator.IsJSON(string(v)) {
+	if !isJSON(string(v)) {
 		return ErrJSON
 	}
 	return nil
 }
 
 func IP[T ~string](v T) error {
-	if !govalidator.IsIP(string(v)) {
+	if !isIP(string(v)) {
 		return ErrIP
 	}
 	return nil
 }
 
 func CIDR[T ~string](v T) error {
-	if !govalidator.IsCIDR(string(v)) {
+	if !isCIDR(string(v)) {
 		return ErrCIDR
 	}
 	return nil
 }
 
 func IPv4[T ~string](v T) error {
-	if !govalidator.IsIPv4(string(v)) {
+	if !isIPv4(string(v)) {
 		return ErrIPv4
 	}
 	return nil
 }
 
 func IPv6[T ~string](v T) error {
-	if !govalidator.IsIPv6(string(v)) {
+	if !isIPv6(string(v)) {
 		return ErrIPv6
 	}
 	return nil
 }
 
 func DNSName[T ~string](v T) error {
-	if !govalidator.IsDNSName(string(v)) {
+	if !isDNSName(string(v)) {
 		return ErrDNSName
 	}
 	return nil
 }
 
 func Host[T ~string](v T) error {
-	if !govalidator.IsHost(string(v)) {
+	if !isHost(string(v)) {
 		return ErrHost
 	}
 	return nil
 }
 
 func Port[T ~string](v T) error {
-	if !govalidator.IsPort(string(v)) {
+	if !isPort(string(v)) {
 		return ErrPort
 	}
 	return nil
 }
 
 func Latitude[T ~string](v T) error {
-	if !govalidator.IsLatitude(string(v)) {
+	if !isLatitude(string(v)) {
 		return ErrLatitude
 	}
 	return nil
 }
 
 func Longitude[T ~string](v T) error {
-	if !govalidator.IsLongitude(string(v)) {
+	if !isLongitude(string(v)) {
 		return ErrLongitude
 	}
 	return nil
 }
 
 func SSN[T ~string](v T) error {
-	if !govalidator.IsSSN(string(v)) {
+	if !isSSN(string(v)) {
 		return ErrSSN
 	}
 	return nil
 }
 
 func Semver[T ~string](v T) error {
-	if !govalidator.IsSemver(string(v)) {
+	if !isSemver(string(v)) {
 		return ErrSemver
 	}
 	return nil
 }
 
 func Base64[T ~string](v T) error {
-	if !govalidator.IsBase64(string(v)) {
+	if !isBase64(string(v)) {
 		return ErrBase64
 	}
 	return nil
 }
 
 func DataURI[T ~string](v T) error {
-	if !govalidator.IsDataURI(string(v)) {
+	if !isDataURI(string(v)) {
 		return ErrDataURI
 	}
 	return nil
 }
 
 func DialString[T ~string](v T) error {
-	if !govalidator.IsDialString(string(v)) {
+	if !isDialString(string(v)) {
 		return ErrDialString
 	}
 	return nil
 }
 
 func RequestURL[T ~string](v T) error {
-	if !govalidator.IsRequestURL(string(v)) {
+	if !isRequestURL(string(v)) {
 		return ErrRequestURL
 	}
 	return nil
 }
 
 func RequestURI[T ~string](v T) error {
-	if !govalidator.IsRequestURI(string(v)) {
+	if !isRequestURI(string(v)) {
 		return ErrRequestURI
 	}
 	return nil
 }
 
 func CreditCard[T ~string](v T) error {
-	if !govalidator.IsCreditCard(string(v)) {
+	if !isCreditCard(string(v)) {
 		return ErrCreditCard
 	}
 	return nil
 }
 
 func ISBN10[T ~string](v T) error {
-	if !govalidator.IsISBN10(string(v)) {
+	if !isISBN10(string(v)) {
 		return ErrISBN10
 	}
 	return nil
 }
 
 func ISBN13[T ~string](v T) error {
-	if !govalidator.IsISBN13(string(v)) {
+	if !isISBN13(string(v)) {
 		return ErrISBN13
 	}
 	return nil
 }
 
 func ISBN[T ~string](v T) error {
-	if !govalidator.IsISBN10(string(v)) && !govalidator.IsISBN13(string(v)) {
+	if !isISBN(string(v)) {
 		return ErrISBN
 	}
 	return nil
 }
 
 func MongoID[T ~string](v T) error {
-	if !govalidator.IsMongoID(string(v)) {
+	if !isMongoID(string(v)) {
 		return ErrMongoID
 	}
 	return nil
 }
 
 func CurrencyCode[T ~string](v T) error {
-	if !govalidator.IsISO4217(string(v)) {
+	if !inCodeSet(currencyCodes, string(v)) {
 		return ErrCurrencyCode
 	}
 	return nil
 }
 
 func CountryCode2L[T ~string](v T) error {
-	if !govalidator.IsISO3166Alpha2(string(v)) {
+	if !inCodeSet(countryCodes2L, string(v)) {
 		return ErrCountryCode2L
 	}
 	return nil
 }
 
 func CountryCode3L[T ~string](v T) error {
-	if !govalidator.IsISO3166Alpha3(string(v)) {
+	if !inCodeSet(countryCodes3L, string(v)) {
 		return ErrCountryCode3L
 	}
 	return nil
 }
 
 func LanguageCode2L[T ~string](v T) error {
-	if !govalidator.IsISO693Alpha2(string(v)) {
+	if !inCodeSet(languageCodes2L, string(v)) {
 		return ErrLanguageCode2L
 	}
 	return nil
 }
 
 func LanguageCode3L[T ~string](v T) error {
-	if !govalidator.IsISO693Alpha3b(string(v)) {
+	if !inCodeSet(languageCodes3L, string(v)) {
 		return ErrLanguageCode3L
 	}
 	return nil
@@ -0,0 +1,342 @@
+package isstr
+
+import (
+	"encoding/json"
+	"unicode"
+	"unicode/utf8"
+	"unsafe"
+)
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isLowerHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f'
+}
+
+func isAlphaByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isAlnumByte(c byte) bool {
+	return isAlphaByte(c) || isDigit(c)
+}
+
+// isSpaceByte reports whether c is matched by \s in regular expressions.
+func isSpaceByte(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\f' || c == '\r'
+}
+
+func isEvery(s string, fn func(c byte) bool) bool {
+	for i := 0; i < len(s); i++ {
+		if !fn(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(s string) bool {
+	return isEvery(s, isAlphaByte)
+}
+
+func isNumeric(s string) bool {
+	return isEvery(s, isDigit)
+}
+
+func isAlphanumeric(s string) bool {
+	return isEvery(s, isAlnumByte)
+}
+
+func isHexColor(s string) bool {
+	if len(s) != 0 && s[0] == '#' {
+		s = s[1:]
+	}
+	return (len(s) == 3 || len(s) == 6) && isEvery(s, isHexDigit)
+}
+
+func isRGBColor(s string) bool {
+	if len(s) < 4 || s[:4] != "rgb(" {
+		return false
+	}
+	s = s[4:]
+	for i := range 3 {
+		s = trimLeftSpace(s)
+		n := 0
+		for n < len(s) && n < 4 && isDigit(s[n]) {
+			n++
+		}
+		if !isByteValue(s[:n]) {
+			return false
+		}
+		s = trimLeftSpace(s[n:])
+
+		sep := byte(',')
+		if i == 2 {
+			sep = ')'
+		}
+		if len(s) == 0 || s[0] != sep {
+			return false
+		}
+		s = s[1:]
+	}
+	return len(s) == 0
+}
+
+// isByteValue reports whether s is a decimal number between 0 and 255
+// without leading zeros.
+func isByteValue(s string) bool {
+	switch {
+	case len(s) == 0 || len(s) > 3 || !isEvery(s, isDigit):
+		return false
+	case len(s) > 1 && s[0] == '0':
+		return false
+	case len(s) == 3:
+		return s <= "255"
+	default:
+		return true
+	}
+}
+
+func trimLeftSpace(s string) string {
+	for len(s) != 0 && isSpaceByte(s[0]) {
+		s = s[1:]
+	}
+	return s
+}
+
+// isCaseMapped reports whether mapping every rune of s leaves it unchanged.
+// Like strings.Map, invalid UTF-8 is never considered unchanged.
+func isCaseMapped(s string, mapping func(r rune) rune) bool {
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 || mapping(r) != r {
+			return false
+		}
+		i += size
+	}
+	return true
+}
+
+func isLowerCase(s string) bool {
+	return isCaseMapped(s, unicode.ToLower)
+}
+
+func isUpperCase(s string) bool {
+	return isCaseMapped(s, unicode.ToUpper)
+}
+
+func isASCII(s string) bool {
+	return isEvery(s, func(c byte) bool { return c < utf8.RuneSelf })
+}
+
+func isPrintableASCII(s string) bool {
+	return isEvery(s, func(c byte) bool { return ' ' <= c && c <= '~' })
+}
+
+// isUUID checks the canonical lowercase form. A zero version matches
+// any version, a variant check requires one of 8, 9, a or b.
+func isUUID(s string, version byte, variant bool) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isLowerHexDigit(s[i]) {
+				return false
+			}
+		}
+	}
+	if version != 0 && s[14] != version {
+		return false
+	}
+	if variant && s[19] != '8' && s[19] != '9' && s[19] != 'a' && s[19] != 'b' {
+		return false
+	}
+	return true
+}
+
+// isULID checks the Crockford base32 alphabet, either case,
+// and that the first character does not overflow 128 bits.
+func isULID(s string) bool {
+	if len(s) != 26 || s[0] > '7' {
+		return false
+	}
+	return isEvery(s, func(c byte) bool {
+		switch c | 0x20 {
+		case 'i', 'l', 'o', 'u':
+			return false
+		}
+		return isAlnumByte(c)
+	})
+}
+
+func isMongoID(s string) bool {
+	return len(s) == 24 && isEvery(s, isHexDigit)
+}
+
+func isSSN(s string) bool {
+	if len(s) != 11 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 3, 6:
+			if s[i] != '-' && s[i] != ' ' {
+				return false
+			}
+		default:
+			if !isDigit(s[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isSemver(s string) bool {
+	if len(s) != 0 && s[0] == 'v' {
+		s = s[1:]
+	}
+
+	for i := range 3 {
+		n := 0
+		for n < len(s) && isDigit(s[n]) {
+			n++
+		}
+		if n == 0 || n > 1 && s[0] == '0' {
+			return false
+		}
+		s = s[n:]
+		if i < 2 {
+			if len(s) == 0 || s[0] != '.' {
+				return false
+			}
+			s = s[1:]
+		}
+	}
+
+	if len(s) != 0 && s[0] == '-' {
+		var pre string
+		pre, s = cutAny(s[1:], '+')
+		if !isDotSeparated(pre, isSemverPrerelease) {
+			return false
+		}
+	}
+	if len(s) != 0 && s[0] == '+' {
+		return isDotSeparated(s[1:], func(id string) bool {
+			return isEvery(id, isSemverIdentByte)
+		})
+	}
+
+	return len(s) == 0
+}
+
+func isSemverIdentByte(c byte) bool {
+	return isAlnumByte(c) || c == '-'
+}
+
+func isSemverPrerelease(id string) bool {
+	if !isEvery(id, isSemverIdentByte) {
+		return false
+	}
+	if isEvery(id, isDigit) {
+		return len(id) == 1 || id[0] != '0'
+	}
+	return true
+}
+
+// isDotSeparated reports whether s is a non-empty list of non-empty
+// dot-separated identifiers that satisfy fn.
+func isDotSeparated(s string, fn func(id string) bool) bool {
+	for {
+		var id string
+		id, s = cutAny(s, '.')
+		if id == "" || !fn(id) {
+			return false
+		}
+		if s == "" {
+			return true
+		}
+		s = s[1:]
+		if s == "" {
+			return false
+		}
+	}
+}
+
+// cutAny slices s around the first occurrence of c,
+// the separator is kept at the beginning of the remainder.
+func cutAny(s string, c byte) (before, after string) {
+	for i := 0; i < len(s); i++ {
+		if s[i] == c {
+			return s[:i], s[i:]
+		}
+	}
+	return s, ""
+}
+
+func isBase64(s string) bool {
+	if len(s) == 0 || len(s)%4 != 0 {
+		return false
+	}
+	n := len(s)
+	switch {
+	case s[n-2] == '=' && s[n-1] == '=':
+		n -= 2
+	case s[n-1] == '=':
+		n--
+	}
+	return isEvery(s[:n], func(c byte) bool {
+		return isAlnumByte(c) || c == '+' || c == '/'
+	})
+}
+
+func isLatitude(s string) bool {
+	return isCoordinate(s, 90)
+}
+
+func isLongitude(s string) bool {
+	return isCoordinate(s, 180)
+}
+
+// isCoordinate checks an optionally signed decimal number with no leading
+// zeros in the integer part whose absolute value does not exceed limit.
+func isCoordinate(s string, limit int) bool {
+	if len(s) != 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+
+	n, v := 0, 0
+	for n < len(s) && isDigit(s[n]) {
+		v = v*10 + int(s[n]-'0')
+		n++
+		if n > 3 {
+			return false
+		}
+	}
+	if n == 0 || n > 1 && s[0] == '0' || v > limit {
+		return false
+	}
+
+	frac := s[n:]
+	if frac == "" {
+		return true
+	}
+	if frac[0] != '.' || len(frac) == 1 || !isEvery(frac[1:], isDigit) {
+		return false
+	}
+	return v < limit || isEvery(frac[1:], func(c byte) bool { return c == '0' })
+}
+
+func isJSON(s string) bool {
+	// json.Valid does not modify or retain its argument,
+	// so there is no need to copy s.
+	return json.Valid(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
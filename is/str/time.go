@@ -0,0 +1,350 @@
+package isstr
+
+import (
+	"strings"
+	"time"
+
+	"github.com/infastin/go-validation"
+)
+
+var (
+	ErrTime               = validation.NewRuleError("is_time", "must be a valid time")
+	ErrRFC3339            = validation.NewRuleError("is_rfc3339", "must be a valid RFC 3339 date-time")
+	ErrISO8601Date        = validation.NewRuleError("is_iso8601_date", "must be a valid ISO 8601 date")
+	ErrISO8601Week        = validation.NewRuleError("is_iso8601_week", "must be a valid ISO 8601 week date")
+	ErrISO8601OrdinalDate = validation.NewRuleError("is_iso8601_ordinal_date", "must be a valid ISO 8601 ordinal date")
+	ErrISO8601Duration    = validation.NewRuleError("is_iso8601_duration", "must be a valid ISO 8601 duration")
+	ErrUnixTimestamp      = validation.NewRuleError("is_unix_timestamp", "must be a valid Unix timestamp")
+)
+
+func Time[T ~string](layout string, rules ...validation.TimeRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		t, err := time.Parse(layout, string(v))
+		if err != nil {
+			return ErrTime
+		}
+		return validateTime(t, rules)
+	}
+}
+
+func RFC3339[T ~string](v T) error {
+	if _, ok := parseRFC3339(string(v), false); !ok {
+		return ErrRFC3339
+	}
+	return nil
+}
+
+func RFC3339By[T ~string](rules ...validation.TimeRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		t, ok := parseRFC3339(string(v), false)
+		if !ok {
+			return ErrRFC3339
+		}
+		return validateTime(t, rules)
+	}
+}
+
+func RFC3339Nano[T ~string](v T) error {
+	if _, ok := parseRFC3339(string(v), true); !ok {
+		return ErrRFC3339
+	}
+	return nil
+}
+
+func RFC3339NanoBy[T ~string](rules ...validation.TimeRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		t, ok := parseRFC3339(string(v), true)
+		if !ok {
+			return ErrRFC3339
+		}
+		return validateTime(t, rules)
+	}
+}
+
+func ISO8601Date[T ~string](v T) error {
+	if _, ok := parseISO8601Date(string(v)); !ok {
+		return ErrISO8601Date
+	}
+	return nil
+}
+
+func ISO8601DateBy[T ~string](rules ...validation.TimeRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		t, ok := parseISO8601Date(string(v))
+		if !ok {
+			return ErrISO8601Date
+		}
+		return validateTime(t, rules)
+	}
+}
+
+func ISO8601Week[T ~string](v T) error {
+	if _, ok := parseISO8601Week(string(v)); !ok {
+		return ErrISO8601Week
+	}
+	return nil
+}
+
+func ISO8601WeekBy[T ~string](rules ...validation.TimeRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		t, ok := parseISO8601Week(string(v))
+		if !ok {
+			return ErrISO8601Week
+		}
+		return validateTime(t, rules)
+	}
+}
+
+func ISO8601OrdinalDate[T ~string](v T) error {
+	if _, ok := parseISO8601OrdinalDate(string(v)); !ok {
+		return ErrISO8601OrdinalDate
+	}
+	return nil
+}
+
+func ISO8601OrdinalDateBy[T ~string](rules ...validation.TimeRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		t, ok := parseISO8601OrdinalDate(string(v))
+		if !ok {
+			return ErrISO8601OrdinalDate
+		}
+		return validateTime(t, rules)
+	}
+}
+
+func ISO8601Duration[T ~string](v T) error {
+	if !isISO8601Duration(string(v)) {
+		return ErrISO8601Duration
+	}
+	return nil
+}
+
+func UnixTimestamp[T ~string](v T) error {
+	if _, ok := parseUnixTimestamp(string(v)); !ok {
+		return ErrUnixTimestamp
+	}
+	return nil
+}
+
+func UnixTimestampBy[T ~string](rules ...validation.TimeRule) validation.StringRuleFunc[T] {
+	return func(v T) error {
+		t, ok := parseUnixTimestamp(string(v))
+		if !ok {
+			return ErrUnixTimestamp
+		}
+		return validateTime(t, rules)
+	}
+}
+
+func validateTime(t time.Time, rules []validation.TimeRule) error {
+	for _, rule := range rules {
+		if err := rule.Validate(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseRFC3339(s string, nano bool) (time.Time, bool) {
+	// time.Parse accepts fractional seconds even if the layout
+	// does not contain them.
+	if !nano && len(s) > 19 && s[19] == '.' {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	return t, err == nil
+}
+
+func parseISO8601Date(s string) (time.Time, bool) {
+	var y, m, d int
+	switch len(s) {
+	case 10:
+		if s[4] != '-' || s[7] != '-' {
+			return time.Time{}, false
+		}
+		y, m, d = parseDigits(s[0:4]), parseDigits(s[5:7]), parseDigits(s[8:10])
+	case 8:
+		y, m, d = parseDigits(s[0:4]), parseDigits(s[4:6]), parseDigits(s[6:8])
+	default:
+		return time.Time{}, false
+	}
+
+	if y < 0 || m < 1 || m > 12 || d < 1 {
+		return time.Time{}, false
+	}
+
+	t := time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC)
+	if t.Day() != d {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+func parseISO8601Week(s string) (time.Time, bool) {
+	var y, w, d int
+	switch {
+	case len(s) == 8 && s[4] == '-' && s[5] == 'W':
+		y, w, d = parseDigits(s[0:4]), parseDigits(s[6:8]), 1
+	case len(s) == 10 && s[4] == '-' && s[5] == 'W' && s[8] == '-':
+		y, w, d = parseDigits(s[0:4]), parseDigits(s[6:8]), parseDigits(s[9:10])
+	case len(s) == 7 && s[4] == 'W':
+		y, w, d = parseDigits(s[0:4]), parseDigits(s[5:7]), 1
+	case len(s) == 8 && s[4] == 'W':
+		y, w, d = parseDigits(s[0:4]), parseDigits(s[5:7]), parseDigits(s[7:8])
+	default:
+		return time.Time{}, false
+	}
+
+	if y < 0 || w < 1 || d < 1 || d > 7 {
+		return time.Time{}, false
+	}
+
+	if _, weeks := time.Date(y, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek(); w > weeks {
+		return time.Time{}, false
+	}
+
+	jan4 := time.Date(y, time.January, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan4.Weekday()) + 6) % 7
+
+	return jan4.AddDate(0, 0, (w-1)*7+d-1-offset), true
+}
+
+func parseISO8601OrdinalDate(s string) (time.Time, bool) {
+	var y, d int
+	switch {
+	case len(s) == 8 && s[4] == '-':
+		y, d = parseDigits(s[0:4]), parseDigits(s[5:8])
+	case len(s) == 7:
+		y, d = parseDigits(s[0:4]), parseDigits(s[4:7])
+	default:
+		return time.Time{}, false
+	}
+
+	if y < 0 || d < 1 || d > 366 {
+		return time.Time{}, false
+	}
+
+	t := time.Date(y, time.January, d, 0, 0, 0, 0, time.UTC)
+	if t.Year() != y {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+func isISO8601Duration(s string) bool {
+	if len(s) < 3 || s[0] != 'P' {
+		return false
+	}
+
+	var (
+		units      = "YMWD"
+		components = 0
+		inTime     = false
+		fraction   = false
+	)
+
+	for i := 1; i < len(s); {
+		if fraction {
+			return false
+		}
+
+		if s[i] == 'T' {
+			if inTime || i == len(s)-1 {
+				return false
+			}
+			inTime, units = true, "HMS"
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		if i == start || i == len(s) {
+			return false
+		}
+
+		if s[i] == '.' || s[i] == ',' {
+			i++
+			start = i
+			for i < len(s) && isDigit(s[i]) {
+				i++
+			}
+			if i == start || i == len(s) {
+				return false
+			}
+			fraction = true
+		}
+
+		j := strings.IndexByte(units, s[i])
+		if j == -1 {
+			return false
+		}
+		units = units[j+1:]
+		components++
+		i++
+	}
+
+	return components != 0 && units != "HMS"
+}
+
+func parseUnixTimestamp(s string) (time.Time, bool) {
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+
+	var sec int64
+	start := i
+	for ; i < len(s) && isDigit(s[i]); i++ {
+		if i-start == 18 {
+			return time.Time{}, false
+		}
+		sec = sec*10 + int64(s[i]-'0')
+	}
+	if i == start {
+		return time.Time{}, false
+	}
+
+	var nsec int64
+	if i < len(s) && s[i] == '.' {
+		i++
+		start = i
+		for ; i < len(s) && isDigit(s[i]); i++ {
+			if i-start == 9 {
+				return time.Time{}, false
+			}
+			nsec = nsec*10 + int64(s[i]-'0')
+		}
+		if i == start {
+			return time.Time{}, false
+		}
+		for j := i - start; j < 9; j++ {
+			nsec *= 10
+		}
+	}
+
+	if i != len(s) {
+		return time.Time{}, false
+	}
+
+	if s[0] == '-' {
+		sec, nsec = -sec, -nsec
+	}
+
+	return time.Unix(sec, nsec).UTC(), true
+}
+
+func parseDigits(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return -1
+		}
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
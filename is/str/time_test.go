@@ -0,0 +1,90 @@
+package isstr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_TimeFormats(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  func(v string) error
+		value string
+		want  error
+	}{
+		{"rfc3339", isstr.RFC3339[string], "2024-03-15T09:30:00Z", nil},
+		{"rfc3339 offset", isstr.RFC3339[string], "2024-03-15T09:30:00+03:00", nil},
+		{"rfc3339 fraction", isstr.RFC3339[string], "2024-03-15T09:30:00.5Z", isstr.ErrRFC3339},
+		{"rfc3339 date", isstr.RFC3339[string], "2024-03-15", isstr.ErrRFC3339},
+		{"rfc3339nano", isstr.RFC3339Nano[string], "2024-03-15T09:30:00.123456789Z", nil},
+		{"rfc3339nano invalid", isstr.RFC3339Nano[string], "2024-03-15T25:30:00Z", isstr.ErrRFC3339},
+		{"date", isstr.ISO8601Date[string], "2024-02-29", nil},
+		{"date basic", isstr.ISO8601Date[string], "20240229", nil},
+		{"date invalid day", isstr.ISO8601Date[string], "2023-02-29", isstr.ErrISO8601Date},
+		{"date mixed", isstr.ISO8601Date[string], "2024-0229", isstr.ErrISO8601Date},
+		{"week", isstr.ISO8601Week[string], "2020-W53", nil},
+		{"week day", isstr.ISO8601Week[string], "2024-W11-5", nil},
+		{"week basic", isstr.ISO8601Week[string], "2024W115", nil},
+		{"week out of range", isstr.ISO8601Week[string], "2024-W53", isstr.ErrISO8601Week},
+		{"week day out of range", isstr.ISO8601Week[string], "2024-W11-8", isstr.ErrISO8601Week},
+		{"ordinal", isstr.ISO8601OrdinalDate[string], "2024-366", nil},
+		{"ordinal basic", isstr.ISO8601OrdinalDate[string], "2024075", nil},
+		{"ordinal out of range", isstr.ISO8601OrdinalDate[string], "2023-366", isstr.ErrISO8601OrdinalDate},
+		{"duration", isstr.ISO8601Duration[string], "P1DT2H", nil},
+		{"duration full", isstr.ISO8601Duration[string], "P1Y2M3W4DT5H6M7.5S", nil},
+		{"duration time", isstr.ISO8601Duration[string], "PT0,5S", nil},
+		{"duration empty", isstr.ISO8601Duration[string], "P", isstr.ErrISO8601Duration},
+		{"duration empty time", isstr.ISO8601Duration[string], "P1DT", isstr.ErrISO8601Duration},
+		{"duration order", isstr.ISO8601Duration[string], "P1D2Y", isstr.ErrISO8601Duration},
+		{"duration time unit", isstr.ISO8601Duration[string], "P1H", isstr.ErrISO8601Duration},
+		{"duration fraction", isstr.ISO8601Duration[string], "P1.5DT1H", isstr.ErrISO8601Duration},
+		{"unix", isstr.UnixTimestamp[string], "1700000000", nil},
+		{"unix fraction", isstr.UnixTimestamp[string], "-1700000000.123", nil},
+		{"unix invalid", isstr.UnixTimestamp[string], "1.7e9", isstr.ErrUnixTimestamp},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule(tt.value); got != tt.want {
+				t.Errorf("Validate(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_TimeFormatsBy(t *testing.T) {
+	var (
+		from = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		tv   = validation.TimeV().BetweenEqual(from, to)
+	)
+	tests := []struct {
+		name  string
+		rule  validation.StringRuleFunc[string]
+		value string
+		want  string
+	}{
+		{"layout", isstr.Time[string](time.Kitchen, validation.TimeV().Required(true)), "3:04PM", ""},
+		{"layout invalid", isstr.Time[string](time.Kitchen), "15:04", "must be a valid time"},
+		{"rfc3339", isstr.RFC3339By[string](tv), "2024-06-01T00:00:00Z", ""},
+		{"rfc3339 out of range", isstr.RFC3339By[string](tv), "2025-06-01T00:00:00Z", "must inclusively be between 2024-01-01 00:00:00 +0000 UTC and 2025-01-01 00:00:00 +0000 UTC"},
+		{"week", isstr.ISO8601WeekBy[string](validation.TimeV().Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))), "2024-W11-5", ""},
+		{"week first", isstr.ISO8601WeekBy[string](validation.TimeV().Equal(time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC))), "2020-W01-1", ""},
+		{"ordinal", isstr.ISO8601OrdinalDateBy[string](validation.TimeV().Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC))), "2024-075", ""},
+		{"unix", isstr.UnixTimestampBy[string](tv), "1717200000", ""},
+		{"unix out of range", isstr.UnixTimestampBy[string](validation.TimeV().Less(from)), "1717200000.5", "must be less than 2024-01-01 00:00:00 +0000 UTC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.rule.Validate(tt.value); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("Validate(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,489 @@
+package isstr
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+// isURL accepts the same strings as the URL check this package used to rely
+// on: the string must be parseable by net/url and match a permissive
+// "[scheme://][user@]host[:port][path]" pattern.
+func isURL(s string) bool {
+	if s == "" || len(s) <= 3 || s[0] == '.' || utf8.RuneCountInString(s) >= 2083 {
+		return false
+	}
+
+	ref, frag, _ := strings.Cut(s, "#")
+	if !isUnescapable(frag, escapeAny) {
+		return false
+	}
+
+	var (
+		u  uriParts
+		ok bool
+	)
+	if strings.IndexByte(s, ':') != -1 && !strings.Contains(s, "://") {
+		// A colon without a scheme separator is most likely a port,
+		// the string is parsed as if it was prefixed with "http://".
+		u, ok = parseURIRest("http", ref, true, false)
+		ok = ok && !hasCTLByte(ref)
+	} else {
+		u, ok = parseURI(ref, false)
+	}
+	if !ok || strings.HasPrefix(u.host, ".") {
+		return false
+	}
+	if u.host == "" && u.path != "" && !hasEscapedDot(u.path) {
+		return false
+	}
+
+	return matchURL(s)
+}
+
+func isRequestURI(s string) bool {
+	_, ok := parseURI(s, true)
+	return ok
+}
+
+func isRequestURL(s string) bool {
+	u, ok := parseURI(s, true)
+	return ok && u.scheme != ""
+}
+
+// isDataURI checks a "data:type/subtype;base64," header followed by
+// a Base64 payload.
+func isDataURI(s string) bool {
+	header, data, found := strings.Cut(s, ",")
+	if !found {
+		return false
+	}
+	mediaType, found := strings.CutPrefix(header, "data:")
+	if !found {
+		return false
+	}
+	if mediaType, found = strings.CutSuffix(mediaType, ";base64"); !found {
+		return false
+	}
+	if len(mediaType) < 3 || strings.IndexByte(mediaType, '\n') != -1 ||
+		strings.IndexByte(mediaType[1:len(mediaType)-1], '/') == -1 {
+		return false
+	}
+	return isBase64(data)
+}
+
+// uriParts holds the raw components of a URI reference.
+type uriParts struct {
+	scheme string
+	host   string
+	path   string
+}
+
+// parseURI reports whether s is accepted by url.Parse, or by url.ParseRequestURI
+// if viaRequest is set. It must be called without the fragment.
+func parseURI(s string, viaRequest bool) (uriParts, bool) {
+	if hasCTLByte(s) || s == "" && viaRequest {
+		return uriParts{}, false
+	}
+	if s == "*" {
+		return uriParts{scheme: "", host: "", path: s}, true
+	}
+
+	scheme, rest, ok := cutScheme(s)
+	if !ok {
+		return uriParts{}, false
+	}
+	return parseURIRest(scheme, rest, false, viaRequest)
+}
+
+// parseURIRest parses what follows the scheme. If authority is set, rest
+// is treated as if it was prefixed with "//".
+func parseURIRest(scheme, rest string, authority, viaRequest bool) (uriParts, bool) {
+	rest, _, _ = strings.Cut(rest, "?")
+
+	if !authority && !strings.HasPrefix(rest, "/") {
+		if scheme != "" {
+			// Rootless paths are opaque.
+			return uriParts{scheme: scheme, host: "", path: ""}, true
+		}
+		if viaRequest {
+			return uriParts{}, false
+		}
+		if segment, _, _ := strings.Cut(rest, "/"); strings.IndexByte(segment, ':') != -1 {
+			return uriParts{}, false
+		}
+	}
+
+	if !authority && (scheme != "" || !viaRequest && !strings.HasPrefix(rest, "///")) && strings.HasPrefix(rest, "//") {
+		authority, rest = true, rest[2:]
+	}
+
+	var host string
+	if authority {
+		host, rest = cutAny(rest, '/')
+		userinfo, hostport := "", host
+		if i := strings.LastIndexByte(host, '@'); i != -1 {
+			userinfo, hostport = host[:i], host[i+1:]
+			if !isEvery(userinfo, isUserinfoByte) || !isUnescapable(userinfo, escapeAny) {
+				return uriParts{}, false
+			}
+		}
+		if !isURIHost(hostport) {
+			return uriParts{}, false
+		}
+		host = hostport
+	}
+
+	if !isUnescapable(rest, escapeAny) {
+		return uriParts{}, false
+	}
+	return uriParts{scheme: scheme, host: host, path: rest}, true
+}
+
+func cutScheme(s string) (scheme, rest string, ok bool) {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isAlphaByte(c):
+		case isDigit(c) || c == '+' || c == '-' || c == '.':
+			if i == 0 {
+				return "", s, true
+			}
+		case c == ':':
+			if i == 0 {
+				return "", "", false
+			}
+			return s[:i], s[i+1:], true
+		default:
+			return "", s, true
+		}
+	}
+	return "", s, true
+}
+
+// isURIHost checks host[:port]. As in net/url without strict colons,
+// the last colon always introduces the port.
+func isURIHost(host string) bool {
+	if i := strings.LastIndexByte(host, '['); i > 0 {
+		return false
+	} else if i == 0 {
+		j := strings.LastIndexByte(host, ']')
+		if j == -1 || !isOptionalPort(host[j+1:]) {
+			return false
+		}
+		return isIPLiteral(host[1:j])
+	} else if i := strings.LastIndexByte(host, ':'); i != -1 && !isOptionalPort(host[i:]) {
+		return false
+	}
+	return isUnescapable(host, escapeHost)
+}
+
+// isIPLiteral checks the contents of the brackets in a host,
+// which must be an IPv6 address with an optional zone.
+func isIPLiteral(s string) bool {
+	if i := strings.Index(s, "%25"); i != -1 {
+		if !isUnescapable(s[:i], escapeHost) || !isUnescapable(s[i:], escapeZone) {
+			return false
+		}
+	} else if !isUnescapable(s, escapeHost) {
+		return false
+	}
+	if strings.IndexByte(s, '%') != -1 {
+		s, _ = url.PathUnescape(s)
+	}
+	addr, err := netip.ParseAddr(s)
+	return err == nil && !addr.Is4()
+}
+
+func isOptionalPort(s string) bool {
+	return s == "" || s[0] == ':' && isEvery(s[1:], isDigit)
+}
+
+func isUserinfoByte(c byte) bool {
+	return isAlnumByte(c) || strings.IndexByte("-._:~!$&'()*+,;=%@", c) != -1
+}
+
+func isHostByte(c byte) bool {
+	return isAlnumByte(c) || strings.IndexByte("-_.~!$&'()*+,;=:[]<>\"", c) != -1
+}
+
+func hasCTLByte(s string) bool {
+	return !isEvery(s, func(c byte) bool { return c >= ' ' && c != 0x7f })
+}
+
+// hasEscapedDot reports whether s contains a dot, escaped or not.
+func hasEscapedDot(s string) bool {
+	return strings.IndexByte(s, '.') != -1 || strings.Contains(s, "%2e") || strings.Contains(s, "%2E")
+}
+
+type escapeMode int
+
+const (
+	escapeAny escapeMode = iota
+	escapeHost
+	escapeZone
+)
+
+// isUnescapable reports whether every percent sign in s starts a valid
+// escape sequence and, for hosts and zones, that neither escaped nor
+// unescaped bytes are out of place.
+func isUnescapable(s string, mode escapeMode) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '%' {
+			if mode != escapeAny && c < utf8.RuneSelf && !isHostByte(c) {
+				return false
+			}
+			continue
+		}
+
+		if i+2 >= len(s) || !isHexDigit(s[i+1]) || !isHexDigit(s[i+2]) {
+			return false
+		}
+		v := unhex(s[i+1])<<4 | unhex(s[i+2])
+		switch {
+		case s[i:i+3] == "%25":
+		case mode == escapeHost && v < 0x80:
+			// Only non-ASCII bytes may be escaped in a host name.
+			return false
+		case mode == escapeZone && v != ' ' && !isHostByte(v):
+			return false
+		}
+		i += 2
+	}
+	return true
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
+// matchURL checks the textual shape of a URL.
+func matchURL(s string) bool {
+	if matchURLAuthority(s) {
+		return true
+	}
+	for _, scheme := range []string{"ftp://", "tcp://", "udp://", "ws://", "wss://", "http://", "https://"} {
+		if rest, found := strings.CutPrefix(s, scheme); found && matchURLAuthority(rest) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchURLAuthority checks the optional user name,
+// the host, the optional port and the optional path.
+func matchURLAuthority(s string) bool {
+	if matchURLHost(s) {
+		return true
+	}
+	// The user name may contain '@' itself, so every one of them is a candidate.
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isSpaceByte(c):
+			return false
+		case c == '@' && i != 0 && matchURLHost(s[i+1:]):
+			return true
+		}
+	}
+	return false
+}
+
+func matchURLHost(s string) bool {
+	var rest string
+	if len(s) != 0 && s[0] == '[' {
+		i := strings.IndexByte(s, ']')
+		if i == -1 || !isURLIPv6(s[1:i]) {
+			return false
+		}
+		rest = strings.TrimPrefix(s[i+1:], ".")
+	} else {
+		i := strings.IndexAny(s, ":/?#")
+		if i == -1 {
+			i = len(s)
+		}
+		host := s[:i]
+		if !isURLHost(host) && (!strings.HasSuffix(host, ".") || !isURLHost(host[:len(host)-1])) {
+			return false
+		}
+		rest = s[i:]
+	}
+
+	if len(rest) != 0 && rest[0] == ':' {
+		n := 1
+		for n < len(rest) && isDigit(rest[n]) {
+			n++
+		}
+		if n == 1 || n > 6 {
+			return false
+		}
+		rest = rest[n:]
+	}
+	if rest == "" {
+		return true
+	}
+	return strings.IndexByte("/?#", rest[0]) != -1 && !strings.ContainsAny(rest[1:], " \t\n\f\r")
+}
+
+// isURLIPv6 checks an IPv6 address, only link-local
+// addresses may have an unescaped zone.
+func isURLIPv6(s string) bool {
+	addr, zone, found := strings.Cut(s, "%")
+	if !found {
+		return isIPv6(s)
+	}
+	if zone == "" || !isEvery(zone, isAlnumByte) {
+		return false
+	}
+	rest, found := strings.CutPrefix(addr, "fe80:")
+	if !found {
+		return false
+	}
+	for n := 0; rest != ""; n++ {
+		if rest[0] != ':' || n == 4 {
+			return false
+		}
+		var group string
+		group, rest = cutAny(rest[1:], ':')
+		if len(group) > 4 || !isEvery(group, isHexDigit) {
+			return false
+		}
+	}
+	return true
+}
+
+// isURLHost checks an IPv4 address or a host name whose last label
+// may not contain digits.
+func isURLHost(s string) bool {
+	if isURLIPv4(s) {
+		return true
+	}
+
+	// The name at the end contains at most one dot, everything before it
+	// must be either a domain or a subdomain.
+	start := 0
+	if i := strings.LastIndexByte(s, '.'); i != -1 {
+		if j := strings.LastIndexByte(s[:i], '.'); j != -1 {
+			start = j + 1
+		}
+	}
+	for i := start; i < len(s); i++ {
+		if isURLName(s[i:]) && (i == 0 || isURLDomain(s[:i]) || isURLSubdomain(s[:i])) {
+			return true
+		}
+	}
+	return false
+}
+
+func isURLIPv4(s string) bool {
+	for i := range 4 {
+		var octet string
+		octet, s = cutAny(s, '.')
+		if i < 3 {
+			if s == "" {
+				return false
+			}
+			s = s[1:]
+		}
+		if len(octet) == 0 || len(octet) > 3 || !isEvery(octet, isDigit) {
+			return false
+		}
+		switch {
+		case len(octet) == 3:
+			if octet < "100" || octet > "255" || i == 0 && octet >= "224" && octet < "240" {
+				return false
+			}
+		case i == 0 && octet[0] == '0':
+			return false
+		}
+	}
+	return s == ""
+}
+
+// isURLName checks a label made of letters and digits with up to two
+// hyphens in a row, optionally followed by a dot and a top-level domain.
+func isURLName(s string) bool {
+	name, tld, found := strings.Cut(s, ".")
+	if found && (tld == "" || !isEveryRune(tld, func(r rune) bool {
+		return r < utf8.RuneSelf && isAlphaByte(byte(r)) || isURLNameRune(r)
+	})) {
+		return false
+	}
+
+	if name == "" || name[0] == '-' || name[len(name)-1] == '-' {
+		return false
+	}
+	hyphens := 0
+	return isEveryRune(name, func(r rune) bool {
+		if r == '-' {
+			hyphens++
+			return hyphens <= 2
+		}
+		hyphens = 0
+		return r < utf8.RuneSelf && isAlnumByte(byte(r)) || isURLNameRune(r)
+	})
+}
+
+func isURLNameRune(r rune) bool {
+	return 0x00a1 <= r && r <= 0xffff
+}
+
+func isEveryRune(s string, fn func(r rune) bool) bool {
+	for _, r := range s {
+		if !fn(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isURLDomain checks a label of at least two characters
+// followed by labels separated by dots or hyphens.
+func isURLDomain(s string) bool {
+	label, rest := cutAny(s, '.')
+	if len(label) < 2 || !isAlnumByte(label[0]) || !isAlnumByte(label[len(label)-1]) ||
+		!isEvery(label, func(c byte) bool { return isAlnumByte(c) || c == '-' || c == '_' }) {
+		return false
+	}
+	return rest == "" || (rest[0] == '-' || rest[0] == '.') && isSeparated(rest[1:], "-.")
+}
+
+// isURLSubdomain checks "www." or labels separated by single dots,
+// hyphens or underscores, that end with two alphanumeric characters,
+// a dot and a label.
+func isURLSubdomain(s string) bool {
+	if s == "www." {
+		return true
+	}
+	i := strings.LastIndexByte(s, '.')
+	if i == -1 {
+		return false
+	}
+	labels, last := s[:i], s[i+1:]
+	return len(labels) >= 2 && isSeparated(labels, "-_.") && isAlnumByte(labels[len(labels)-2]) &&
+		last != "" && isEvery(last, isAlnumByte)
+}
+
+// isSeparated reports whether s consists of alphanumeric runs
+// joined by single separators.
+func isSeparated(s, separators string) bool {
+	sep := true
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case isAlnumByte(c):
+			sep = false
+		case strings.IndexByte(separators, c) != -1 && !sep:
+			sep = true
+		default:
+			return false
+		}
+	}
+	return !sep
+}
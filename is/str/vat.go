@@ -0,0 +1,476 @@
+package isstr
+
+import (
+	"strings"
+
+	"github.com/infastin/go-validation"
+	"github.com/infastin/go-validation/checkdigit"
+)
+
+var (
+	ErrVATNumber   = validation.NewRuleError("is_vat_number", "must be a valid VAT number")
+	ErrVATCountry  = validation.NewRuleError("vat_country", "must be a VAT number of a supported country")
+	ErrVATFormat   = validation.NewRuleError("vat_format", "must match the VAT number format of its country")
+	ErrVATChecksum = validation.NewRuleError("vat_checksum", "must be a VAT number with a valid check digit")
+)
+
+// VATNumber checks a VAT identification number in the format used by VIES,
+// that is, a country prefix followed by the national number in upper case
+// and without spaces or punctuation. All EU member states are supported,
+// Greece uses the EL prefix and Northern Ireland uses XI. The numbers of
+// Switzerland (CHE), Norway and the United Kingdom are supported as well.
+func VATNumber[T ~string](v T) error {
+	s := string(v)
+	if len(s) < 4 || !isUpperAlphaByte(s[0]) || !isUpperAlphaByte(s[1]) || !isEvery(s[2:], isVATByte) {
+		return ErrVATNumber
+	}
+	check, ok := vatFormats[s[:2]]
+	if !ok {
+		return ErrVATCountry
+	}
+	return check(s[2:])
+}
+
+// vatFormats maps VAT prefixes to the functions that check
+// the national part of the number.
+var vatFormats = map[string]func(s string) error{
+	"AT": checkVATAT,
+	"BE": checkVATBE,
+	"BG": checkVATBG,
+	"CH": checkVATCH,
+	"CY": checkVATCY,
+	"CZ": checkVATCZ,
+	"DE": checkVATDE,
+	"DK": checkVATDK,
+	"EE": checkVATEE,
+	"EL": checkVATEL,
+	"ES": checkVATES,
+	"FI": checkVATFI,
+	"FR": checkVATFR,
+	"GB": checkVATGB,
+	"HR": checkVATHR,
+	"HU": checkVATHU,
+	"IE": checkVATIE,
+	"IT": checkVATIT,
+	"LT": checkVATLT,
+	"LU": checkVATLU,
+	"LV": checkVATLV,
+	"MT": checkVATMT,
+	"NL": checkVATNL,
+	"NO": checkVATNO,
+	"PL": checkVATPL,
+	"PT": checkVATPT,
+	"RO": checkVATRO,
+	"SE": checkVATSE,
+	"SI": checkVATSI,
+	"SK": checkVATSK,
+	"XI": checkVATGB,
+}
+
+// isVATByte also accepts the '+' and '*' used by old Irish numbers.
+func isVATByte(c byte) bool {
+	return isUpperAlnumByte(c) || c == '+' || c == '*'
+}
+
+func vatResult(ok bool) error {
+	if !ok {
+		return ErrVATChecksum
+	}
+	return nil
+}
+
+func isVATDigits(s string, length int) bool {
+	return len(s) == length && isEvery(s, isDigit)
+}
+
+func checkVATAT(s string) error {
+	if len(s) != 9 || s[0] != 'U' || !isVATDigits(s[1:], 8) {
+		return ErrVATFormat
+	}
+	sum := 0
+	for i := 1; i < 8; i++ {
+		d := int(s[i] - '0')
+		if i%2 == 0 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	return vatResult((10-(sum+4)%10)%10 == int(s[8]-'0'))
+}
+
+func checkVATBE(s string) error {
+	if len(s) == 9 {
+		s = "0" + s
+	}
+	if !isVATDigits(s, 10) || s[0] > '1' {
+		return ErrVATFormat
+	}
+	return vatResult(97-digitsValue(s[:8])%97 == digitsValue(s[8:]))
+}
+
+func checkVATBG(s string) error {
+	switch {
+	case isVATDigits(s, 9):
+		c := weightedSum(s[:8], 1, 2, 3, 4, 5, 6, 7, 8) % 11
+		if c == 10 {
+			if c = weightedSum(s[:8], 3, 4, 5, 6, 7, 8, 9, 10) % 11; c == 10 {
+				c = 0
+			}
+		}
+		return vatResult(c == int(s[8]-'0'))
+	case isVATDigits(s, 10):
+		last := int(s[9] - '0')
+		// Physical persons, foreigners and other taxpayers have different check digits.
+		person := weightedSum(s[:9], 2, 4, 8, 5, 10, 9, 7, 3, 6) % 11 % 10
+		foreigner := weightedSum(s[:9], 21, 19, 17, 13, 11, 9, 7, 3, 1) % 10
+		other := 11 - weightedSum(s[:9], 4, 3, 2, 7, 6, 5, 4, 3, 2)%11
+		return vatResult(person == last || foreigner == last || other%11 == last)
+	default:
+		return ErrVATFormat
+	}
+}
+
+func checkVATCH(s string) error {
+	if len(s) != 10 || s[0] != 'E' || !isVATDigits(s[1:], 9) {
+		return ErrVATFormat
+	}
+	c := 11 - weightedSum(s[1:9], 5, 4, 3, 2, 7, 6, 5, 4)%11
+	return vatResult(c%11 == int(s[9]-'0'))
+}
+
+func checkVATCY(s string) error {
+	if len(s) != 9 || !isVATDigits(s[:8], 8) || !isUpperAlphaByte(s[8]) || s[0] == '2' {
+		return ErrVATFormat
+	}
+	odd := [10]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		if d := int(s[i] - '0'); i%2 == 0 {
+			sum += odd[d]
+		} else {
+			sum += d
+		}
+	}
+	return vatResult(byte('A'+sum%26) == s[8])
+}
+
+func checkVATCZ(s string) error {
+	switch {
+	case isVATDigits(s, 8):
+		// Legal entities.
+		c := 11 - weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2)%11
+		return vatResult(c%10 == int(s[7]-'0'))
+	case isVATDigits(s, 9):
+		// Birth numbers issued before 1954 have no check digit.
+		return nil
+	case isVATDigits(s, 10):
+		// Birth numbers.
+		return vatResult(digitsValue(s)%11 == 0 || digitsValue(s[:9])%11 == 10 && s[9] == '0')
+	default:
+		return ErrVATFormat
+	}
+}
+
+func checkVATDE(s string) error {
+	if !isVATDigits(s, 9) || s[0] == '0' {
+		return ErrVATFormat
+	}
+	return vatResult(mod11_10(s[:8]) == int(s[8]-'0'))
+}
+
+func checkVATDK(s string) error {
+	if !isVATDigits(s, 8) || s[0] == '0' {
+		return ErrVATFormat
+	}
+	return vatResult(weightedSum(s, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0)
+}
+
+func checkVATEE(s string) error {
+	if !isVATDigits(s, 9) || s[:2] != "10" {
+		return ErrVATFormat
+	}
+	c := 10 - weightedSum(s[:8], 3, 7, 1, 3, 7, 1, 3, 7)%10
+	return vatResult(c%10 == int(s[8]-'0'))
+}
+
+func checkVATEL(s string) error {
+	if !isVATDigits(s, 9) {
+		return ErrVATFormat
+	}
+	c := weightedSum(s[:8], 256, 128, 64, 32, 16, 8, 4, 2) % 11 % 10
+	return vatResult(c == int(s[8]-'0'))
+}
+
+func checkVATES(s string) error {
+	if len(s) != 9 || !isVATDigits(s[1:8], 7) {
+		return ErrVATFormat
+	}
+	switch first, last := s[0], s[8]; {
+	case isDigit(first):
+		// Spanish citizens.
+		if !isUpperAlphaByte(last) {
+			return ErrVATFormat
+		}
+		return vatResult(spanishIDLetter(digitsValue(s[:8])) == last)
+	case strings.IndexByte("XYZ", first) != -1:
+		// Foreign residents.
+		if !isUpperAlphaByte(last) {
+			return ErrVATFormat
+		}
+		return vatResult(spanishIDLetter(int(first-'X')*10_000_000+digitsValue(s[1:8])) == last)
+	case strings.IndexByte("KLM", first) != -1:
+		if !isUpperAlphaByte(last) {
+			return ErrVATFormat
+		}
+		return vatResult(spanishIDLetter(digitsValue(s[1:8])) == last)
+	case strings.IndexByte("ABCDEFGHJNPQRSUVW", first) != -1:
+		// Legal entities.
+		sum := 0
+		for i := 1; i < 8; i++ {
+			d := int(s[i] - '0')
+			if i%2 == 1 {
+				d = d*2/10 + d*2%10
+			}
+			sum += d
+		}
+		c := (10 - sum%10) % 10
+		return vatResult(last == byte('0'+c) || last == "JABCDEFGHI"[c])
+	default:
+		return ErrVATFormat
+	}
+}
+
+func checkVATFI(s string) error {
+	if !isVATDigits(s, 8) {
+		return ErrVATFormat
+	}
+	c := 11 - weightedSum(s[:7], 7, 9, 10, 5, 8, 4, 2)%11
+	return vatResult(c != 10 && c%11 == int(s[7]-'0'))
+}
+
+func checkVATFR(s string) error {
+	if len(s) != 11 || !isVATDigits(s[2:], 9) {
+		return ErrVATFormat
+	}
+	if !isDigit(s[0]) || !isDigit(s[1]) {
+		// Alphanumeric keys are assigned to new businesses
+		// and have no published algorithm.
+		if !isUpperAlnumByte(s[0]) || !isUpperAlnumByte(s[1]) || strings.ContainsAny(s[:2], "IO") {
+			return ErrVATFormat
+		}
+		return nil
+	}
+	return vatResult((12+3*(digitsValue(s[2:])%97))%97 == digitsValue(s[:2]))
+}
+
+func checkVATGB(s string) error {
+	switch {
+	case len(s) == 5 && (s[:2] == "GD" || s[:2] == "HA"):
+		// Government departments and health authorities.
+		if !isVATDigits(s[2:], 3) || (s[:2] == "GD") != (digitsValue(s[2:]) < 500) {
+			return ErrVATFormat
+		}
+		return nil
+	case isVATDigits(s, 9), isVATDigits(s, 12):
+		sum := weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2) + digitsValue(s[7:9])
+		return vatResult(sum%97 == 0 || (sum+55)%97 == 0)
+	default:
+		return ErrVATFormat
+	}
+}
+
+func checkVATHR(s string) error {
+	if !isVATDigits(s, 11) {
+		return ErrVATFormat
+	}
+	return vatResult(mod11_10(s[:10]) == int(s[10]-'0'))
+}
+
+func checkVATHU(s string) error {
+	if !isVATDigits(s, 8) {
+		return ErrVATFormat
+	}
+	c := 10 - weightedSum(s[:7], 9, 7, 3, 1, 9, 7, 3)%10
+	return vatResult(c%10 == int(s[7]-'0'))
+}
+
+func checkVATIE(s string) error {
+	// The old format has a letter, '+' or '*' in the second position
+	// and is converted to the new one.
+	if len(s) == 8 && isDigit(s[0]) && (isUpperAlphaByte(s[1]) || s[1] == '+' || s[1] == '*') {
+		s = "0" + s[2:7] + s[:1] + s[7:]
+	}
+	if len(s) != 8 && len(s) != 9 || !isVATDigits(s[:7], 7) || !isUpperAlphaByte(s[7]) {
+		return ErrVATFormat
+	}
+	sum := weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2)
+	if len(s) == 9 {
+		switch c := s[8]; {
+		case c == 'W':
+		case c >= 'A' && c <= 'I':
+			sum += 9 * int(c-'A'+1)
+		default:
+			return ErrVATFormat
+		}
+	}
+	return vatResult("WABCDEFGHIJKLMNOPQRSTUV"[sum%23] == s[7])
+}
+
+func checkVATIT(s string) error {
+	if !isVATDigits(s, 11) {
+		return ErrVATFormat
+	}
+	if p := digitsValue(s[7:10]); p == 0 || p > 100 && p != 120 && p != 121 && p != 888 && p != 999 {
+		return ErrVATFormat
+	}
+	return vatResult(checkdigit.Luhn(s))
+}
+
+func checkVATLT(s string) error {
+	if !isVATDigits(s, 9) && !isVATDigits(s, 12) || s[len(s)-2] != '1' {
+		return ErrVATFormat
+	}
+	sum := 0
+	for i := 0; i < len(s)-1; i++ {
+		sum += (1 + i%9) * int(s[i]-'0')
+	}
+	if sum%11 == 10 {
+		sum = 0
+		for i := 0; i < len(s)-1; i++ {
+			sum += (1 + (i+2)%9) * int(s[i]-'0')
+		}
+	}
+	return vatResult(sum%11%10 == int(s[len(s)-1]-'0'))
+}
+
+func checkVATLU(s string) error {
+	if !isVATDigits(s, 8) {
+		return ErrVATFormat
+	}
+	return vatResult(digitsValue(s[:6])%89 == digitsValue(s[6:]))
+}
+
+func checkVATLV(s string) error {
+	if !isVATDigits(s, 11) {
+		return ErrVATFormat
+	}
+	switch {
+	case s[0] > '3':
+		// Legal entities.
+		return vatResult(weightedSum(s, 9, 1, 4, 8, 3, 10, 2, 5, 7, 6, 1)%11 == 3)
+	case s[:2] == "32":
+		// Personal codes issued since 2017 have no check digit.
+		return nil
+	default:
+		c := (1 + weightedSum(s[:10], 10, 5, 8, 4, 2, 1, 6, 3, 7, 9)) % 11 % 10
+		return vatResult(c == int(s[10]-'0'))
+	}
+}
+
+func checkVATMT(s string) error {
+	if !isVATDigits(s, 8) || s[0] == '0' {
+		return ErrVATFormat
+	}
+	return vatResult(37-weightedSum(s[:6], 3, 4, 6, 7, 8, 9)%37 == digitsValue(s[6:]))
+}
+
+func checkVATNL(s string) error {
+	if len(s) != 12 || !isVATDigits(s[:9], 9) || s[9] != 'B' || !isVATDigits(s[10:], 2) {
+		return ErrVATFormat
+	}
+	// Sole proprietors have numbers checked with ISO 7064 MOD 97-10
+	// over the whole number including the country prefix.
+	if weightedSum(s[:8], 9, 8, 7, 6, 5, 4, 3, 2)%11 == int(s[8]-'0') {
+		return nil
+	}
+	r, _ := checkdigit.Mod97("NL" + s)
+	return vatResult(r == 1)
+}
+
+func checkVATNO(s string) error {
+	s = strings.TrimSuffix(s, "MVA")
+	if !isVATDigits(s, 9) {
+		return ErrVATFormat
+	}
+	c := 11 - weightedSum(s[:8], 3, 2, 7, 6, 5, 4, 3, 2)%11
+	return vatResult(c != 10 && c%11 == int(s[8]-'0'))
+}
+
+func checkVATPL(s string) error {
+	if !isVATDigits(s, 10) {
+		return ErrVATFormat
+	}
+	return vatResult(weightedSum(s[:9], 6, 5, 7, 2, 3, 4, 5, 6, 7)%11 == int(s[9]-'0'))
+}
+
+func checkVATPT(s string) error {
+	if !isVATDigits(s, 9) || s[0] == '0' {
+		return ErrVATFormat
+	}
+	c := 11 - weightedSum(s[:8], 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if c >= 10 {
+		c = 0
+	}
+	return vatResult(c == int(s[8]-'0'))
+}
+
+func checkVATRO(s string) error {
+	if len(s) < 2 || len(s) > 10 || !isEvery(s, isDigit) || s[0] == '0' {
+		return ErrVATFormat
+	}
+	weights := []int{7, 5, 3, 2, 1, 7, 5, 3, 2}
+	c := weightedSum(s[:len(s)-1], weights[10-len(s):]...) * 10 % 11 % 10
+	return vatResult(c == int(s[len(s)-1]-'0'))
+}
+
+func checkVATSE(s string) error {
+	if !isVATDigits(s, 12) || s[10:] != "01" {
+		return ErrVATFormat
+	}
+	return vatResult(checkdigit.Luhn(s[:10]))
+}
+
+func checkVATSI(s string) error {
+	if !isVATDigits(s, 8) || s[0] == '0' {
+		return ErrVATFormat
+	}
+	c := 11 - weightedSum(s[:7], 8, 7, 6, 5, 4, 3, 2)%11
+	return vatResult(c != 11 && c%10 == int(s[7]-'0'))
+}
+
+func checkVATSK(s string) error {
+	if !isVATDigits(s, 10) || s[0] == '0' || strings.IndexByte("234789", s[2]) == -1 {
+		return ErrVATFormat
+	}
+	return vatResult(digitsValue(s)%11 == 0)
+}
+
+// weightedSum returns the sum of the digits of s multiplied by weights.
+func weightedSum(s string, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += w * int(s[i]-'0')
+	}
+	return sum
+}
+
+// digitsValue returns the value of a short string of digits.
+func digitsValue(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
+
+// mod11_10 returns the ISO 7064 MOD 11,10 check digit of s.
+func mod11_10(s string) int {
+	p := 10
+	for i := 0; i < len(s); i++ {
+		if p = (int(s[i]-'0') + p) % 10; p == 0 {
+			p = 10
+		}
+		p = 2 * p % 11
+	}
+	return (11 - p) % 10
+}
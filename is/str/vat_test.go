@@ -0,0 +1,75 @@
+package isstr_test
+
+import (
+	"testing"
+
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+func Test_VATNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  error
+	}{
+		{"austria", "ATU13585627", nil},
+		{"belgium", "BE0403019261", nil},
+		{"belgium old format", "BE403019261", nil},
+		{"bulgaria", "BG175074752", nil},
+		{"cyprus", "CY10259033P", nil},
+		{"czechia", "CZ25123891", nil},
+		{"germany", "DE136695976", nil},
+		{"denmark", "DK13585628", nil},
+		{"estonia", "EE100931558", nil},
+		{"greece", "EL094259216", nil},
+		{"spain legal entity", "ESA13585625", nil},
+		{"spain citizen", "ES12345678Z", nil},
+		{"spain foreigner", "ESX2482300W", nil},
+		{"finland", "FI20774740", nil},
+		{"france", "FR40303265045", nil},
+		{"france alphanumeric key", "FRK7399859412", nil},
+		{"croatia", "HR33392005961", nil},
+		{"hungary", "HU12892312", nil},
+		{"ireland", "IE6433435F", nil},
+		{"ireland two letters", "IE6433435OA", nil},
+		{"ireland old format", "IE8Z49289F", nil},
+		{"italy", "IT00743110157", nil},
+		{"lithuania", "LT119511515", nil},
+		{"luxembourg", "LU15027442", nil},
+		{"latvia", "LV40003521600", nil},
+		{"malta", "MT11679112", nil},
+		{"netherlands", "NL004495445B01", nil},
+		{"poland", "PL8567346215", nil},
+		{"portugal", "PT501964843", nil},
+		{"romania", "RO18547290", nil},
+		{"sweden", "SE123456789701", nil},
+		{"slovenia", "SI50223054", nil},
+		{"slovakia", "SK2022749619", nil},
+		{"united kingdom", "GB980780684", nil},
+		{"northern ireland", "XI980780684", nil},
+		{"united kingdom government", "GBGD001", nil},
+		{"switzerland", "CHE116281710", nil},
+		{"norway", "NO995525828MVA", nil},
+		{"lower case", "de136695976", isstr.ErrVATNumber},
+		{"spaces", "DE 136695976", isstr.ErrVATNumber},
+		{"too short", "DE1", isstr.ErrVATNumber},
+		{"greece with iso code", "GR094259216", isstr.ErrVATCountry},
+		{"unsupported country", "US123456789", isstr.ErrVATCountry},
+		{"germany too long", "DE1366959760", isstr.ErrVATFormat},
+		{"austria without U", "AT13585627", isstr.ErrVATFormat},
+		{"netherlands without B", "NL004495445001", isstr.ErrVATFormat},
+		{"united kingdom health authority", "GBHA001", isstr.ErrVATFormat},
+		{"germany checksum", "DE136695977", isstr.ErrVATChecksum},
+		{"france checksum", "FR41303265045", isstr.ErrVATChecksum},
+		{"poland checksum", "PL8567346216", isstr.ErrVATChecksum},
+		{"spain checksum", "ES12345678A", isstr.ErrVATChecksum},
+		{"ireland checksum", "IE6433435G", isstr.ErrVATChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isstr.VATNumber(tt.value); got != tt.want {
+				t.Errorf("VATNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
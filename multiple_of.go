@@ -0,0 +1,47 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/infastin/go-validation/constraints"
+)
+
+type multipleOfRule[T constraints.Number] struct {
+	step       T
+	buildError func() error
+}
+
+func MultipleOf[T constraints.Number](step T) multipleOfRule[T] {
+	return multipleOfRule[T]{
+		step: step,
+		buildError: func() error {
+			return buildMultipleOfError(step)
+		},
+	}
+}
+
+func (r multipleOfRule[T]) Validate(n T) error {
+	if !isMultipleOf(n, r.step) {
+		return r.buildError()
+	}
+	return nil
+}
+
+func isMultipleOf[T constraints.Number](n, step T) bool {
+	switch {
+	case step == 0:
+		return n == 0
+	case isFloat[T]():
+		q := float64(n) / float64(step)
+		return math.Abs(q-math.Round(q)) <= 1e-9*max(1, math.Abs(q))
+	case isSigned[T]():
+		return int64(n)%int64(step) == 0
+	default:
+		return uint64(n)%uint64(step) == 0
+	}
+}
+
+func buildMultipleOfError(step any) error {
+	return NewRuleError("multiple_of", fmt.Sprintf("must be a multiple of %v", step))
+}
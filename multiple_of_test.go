@@ -0,0 +1,73 @@
+package validation_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_MultipleOf_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"int", validation.MultipleOf(5).Validate(15), true},
+		{"int negative", validation.MultipleOf(5).Validate(-15), true},
+		{"int not multiple", validation.MultipleOf(5).Validate(16), false},
+		{"uint", validation.MultipleOf[uint64](3).Validate(math.MaxUint64), true},
+		{"zero step", validation.MultipleOf(0).Validate(1), false},
+		{"float", validation.MultipleOf(0.01).Validate(19.99), true},
+		{"float not multiple", validation.MultipleOf(0.25).Validate(1.3), false},
+		{"float NaN", validation.MultipleOf(0.25).Validate(math.NaN()), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err == nil; got != tt.want {
+				t.Errorf("MultipleOf.Validate() = %v, want valid %v", tt.err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_MaxDecimalPlaces_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"integer", validation.MaxDecimalPlaces[float64](2).Validate(100), true},
+		{"two places", validation.MaxDecimalPlaces[float64](2).Validate(19.99), true},
+		{"three places", validation.MaxDecimalPlaces[float64](2).Validate(19.999), false},
+		{"float32", validation.MaxDecimalPlaces[float32](2).Validate(0.1), true},
+		{"int", validation.MaxDecimalPlaces[int](0).Validate(10), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err == nil; got != tt.want {
+				t.Errorf("MaxDecimalPlaces.Validate() = %v, want valid %v", tt.err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Finite_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		n    float64
+		want error
+	}{
+		{"number", 1.5, nil},
+		{"NaN", math.NaN(), validation.ErrFinite},
+		{"+Inf", math.Inf(1), validation.ErrFinite},
+		{"-Inf", math.Inf(-1), validation.ErrFinite},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validation.Finite[float64]().Validate(tt.n); got != tt.want {
+				t.Errorf("Finite.Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
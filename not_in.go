@@ -1,6 +1,11 @@
 package validation
 
-import "time"
+import (
+	"time"
+
+	"github.com/infastin/go-validation/civil"
+	"github.com/infastin/go-validation/constraints"
+)
 
 var ErrNotInInvalid = NewRuleError("not_in_invalid", "must not be in list")
 
@@ -62,3 +67,44 @@ func (r notInTimeRule) Validate(t time.Time) error {
 	}
 	return nil
 }
+
+type notInBigRule[T constraints.Big[T]] struct {
+	elements []T
+}
+
+func NotInBig[T constraints.Big[T]](elements ...T) notInBigRule[T] {
+	return notInBigRule[T]{
+		elements: elements,
+	}
+}
+
+func (r notInBigRule[T]) Validate(v T) error {
+	if v == nil {
+		return nil
+	}
+	for i := range r.elements {
+		if v.Cmp(r.elements[i]) == 0 {
+			return ErrNotInInvalid
+		}
+	}
+	return nil
+}
+
+type notInDateRule struct {
+	elements []civil.Date
+}
+
+func NotInDate(elements ...civil.Date) notInDateRule {
+	return notInDateRule{
+		elements: elements,
+	}
+}
+
+func (r notInDateRule) Validate(d civil.Date) error {
+	for i := range r.elements {
+		if d == r.elements[i] {
+			return ErrNotInInvalid
+		}
+	}
+	return nil
+}
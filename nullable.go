@@ -0,0 +1,206 @@
+package validation
+
+import (
+	"database/sql"
+	"slices"
+	"time"
+)
+
+type nullableValidatorData[T any] struct {
+	value T
+	valid bool
+	name  string
+}
+
+type NullableValidator[T any] struct {
+	data  *nullableValidatorData[T]
+	rules []NullableRule[T]
+	scope validatorScope
+}
+
+func Nullable[T any](v T, valid bool, name string) NullableValidator[T] {
+	return NullableValidator[T]{
+		data: &nullableValidatorData[T]{
+			value: v,
+			valid: valid,
+			name:  name,
+		},
+		rules: make([]NullableRule[T], 0),
+		scope: nil,
+	}
+}
+
+func NullableI[T any](v T, valid bool) NullableValidator[T] {
+	return NullableValidator[T]{
+		data: &nullableValidatorData[T]{
+			value: v,
+			valid: valid,
+			name:  "",
+		},
+		rules: make([]NullableRule[T], 0),
+		scope: nil,
+	}
+}
+
+func NullableV[T any]() NullableValidator[T] {
+	return NullableValidator[T]{
+		data:  nil,
+		rules: make([]NullableRule[T], 0),
+		scope: nil,
+	}
+}
+
+// NullableValue is implemented by user-defined optional types.
+// Get returns the value and whether it is present.
+//
+// The method is not called Value to avoid a clash with driver.Valuer,
+// which nullable database types usually implement too.
+type NullableValue[T any] interface {
+	Get() (v T, valid bool)
+}
+
+func NullableOf[T any](n NullableValue[T], name string) NullableValidator[T] {
+	v, valid := n.Get()
+	return Nullable(v, valid, name)
+}
+
+func NullSQL[T any](n sql.Null[T], name string) NullableValidator[T] {
+	return Nullable(n.V, n.Valid, name)
+}
+
+func NullString(n sql.NullString, name string) NullableValidator[string] {
+	return Nullable(n.String, n.Valid, name)
+}
+
+func NullInt64(n sql.NullInt64, name string) NullableValidator[int64] {
+	return Nullable(n.Int64, n.Valid, name)
+}
+
+func NullInt32(n sql.NullInt32, name string) NullableValidator[int32] {
+	return Nullable(n.Int32, n.Valid, name)
+}
+
+func NullInt16(n sql.NullInt16, name string) NullableValidator[int16] {
+	return Nullable(n.Int16, n.Valid, name)
+}
+
+func NullByte(n sql.NullByte, name string) NullableValidator[byte] {
+	return Nullable(n.Byte, n.Valid, name)
+}
+
+func NullFloat64(n sql.NullFloat64, name string) NullableValidator[float64] {
+	return Nullable(n.Float64, n.Valid, name)
+}
+
+func NullBool(n sql.NullBool, name string) NullableValidator[bool] {
+	return Nullable(n.Bool, n.Valid, name)
+}
+
+func NullTime(n sql.NullTime, name string) NullableValidator[time.Time] {
+	return Nullable(n.Time, n.Valid, name)
+}
+
+func (nv NullableValidator[T]) If(condition bool) NullableValidator[T] {
+	if nv.scope.Ok() {
+		nv.scope = nv.scope.Push(condition)
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) ElseIf(condition bool) NullableValidator[T] {
+	if !nv.scope.Ok() {
+		nv.scope.Set(condition)
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) Else() NullableValidator[T] {
+	if !nv.scope.Ok() {
+		nv.scope.Set(true)
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) Break(condition bool) NullableValidator[T] {
+	if !nv.scope.Empty() && condition {
+		nv.scope.Set(false)
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) EndIf() NullableValidator[T] {
+	if !nv.scope.Empty() {
+		nv.scope = nv.scope.Pop()
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) Required(condition bool) NullableValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, RequiredNullable[T](condition))
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) Nil(condition bool) NullableValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, NilNullable[T](condition))
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) With(fns ...func(v T, valid bool) error) NullableValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = slices.Grow(nv.rules, len(fns))
+		for _, fn := range fns {
+			nv.rules = append(nv.rules, NullableRuleFunc[T](fn))
+		}
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) By(rules ...NullableRule[T]) NullableValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, rules...)
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) ValueBy(rules ...AnyRule[T]) NullableValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, OptionalNullable(rules...))
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) ValueWith(fns ...func(v T) error) NullableValidator[T] {
+	if nv.scope.Ok() {
+		rules := make([]AnyRule[T], 0, len(fns))
+		for _, fn := range fns {
+			rules = append(rules, AnyRuleFunc[T](fn))
+		}
+		nv.rules = append(nv.rules, OptionalNullable(rules...))
+	}
+	return nv
+}
+
+func (nv NullableValidator[T]) Valid() error {
+	for _, rule := range nv.rules {
+		if err := rule.Validate(nv.data.value, nv.data.valid); err != nil {
+			if nv.data.name != "" {
+				err = NewValueError(nv.data.name, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (nv NullableValidator[T]) Validate(v T, valid bool) error {
+	for _, rule := range nv.rules {
+		if err := rule.Validate(v, valid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package validation_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation"
+	isstr "github.com/infastin/go-validation/is/str"
+)
+
+type optional[T any] struct {
+	value T
+	ok    bool
+}
+
+func (o optional[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+func Test_NullableValidator_Valid(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		validator validation.Validator
+		want      string
+	}{
+		{
+			"null string",
+			validation.NullString(sql.NullString{String: "", Valid: false}, "email").ValueWith(isstr.Email),
+			"",
+		},
+		{
+			"invalid string",
+			validation.NullString(sql.NullString{String: "foo", Valid: true}, "email").ValueWith(isstr.Email),
+			"email: must be a valid email address",
+		},
+		{
+			"required",
+			validation.NullSQL(sql.Null[int]{V: 0, Valid: false}, "count").Required(true),
+			"count: cannot be blank",
+		},
+		{
+			"valid number",
+			validation.NullSQL(sql.Null[int]{V: 0, Valid: true}, "count").Required(true).ValueBy(validation.NumberV[int]().LessEqual(10)),
+			"",
+		},
+		{
+			"invalid time",
+			validation.NullTime(sql.NullTime{Time: now, Valid: true}, "deleted_at").ValueBy(validation.TimeV().Greater(now)),
+			"deleted_at: must be greater than 2024-01-01 00:00:00 +0000 UTC",
+		},
+		{
+			"nil",
+			validation.Nullable(42, true, "legacy").Nil(true),
+			"legacy: must be blank",
+		},
+		{
+			"user-defined wrapper",
+			validation.NullableOf[string](optional[string]{"foo", true}, "email").ValueWith(isstr.Email),
+			"email: must be a valid email address",
+		},
+		{
+			"absent user-defined wrapper",
+			validation.NullableOf[string](optional[string]{}, "email").Required(true),
+			"email: cannot be blank",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.validator.Valid(); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("NullableValidator.Valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
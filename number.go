@@ -152,9 +152,72 @@ func (nv NumberValidator[T]) BetweenEqual(a, b T) NumberValidator[T] {
 	return nv
 }
 
+func (nv NumberValidator[T]) EqualApprox(v, epsilon T) NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, EqualApprox(v, epsilon))
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) MultipleOf(step T) NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, MultipleOf(step))
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) Positive() NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, Positive[T]())
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) Negative() NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, Negative[T]())
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) NonZero() NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, NonZero[T]())
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) NonNegative() NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, NonNegative[T]())
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) NonPositive() NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, NonPositive[T]())
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) Finite() NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, Finite[T]())
+	}
+	return nv
+}
+
+func (nv NumberValidator[T]) MaxDecimalPlaces(max int) NumberValidator[T] {
+	if nv.scope.Ok() {
+		nv.rules = append(nv.rules, MaxDecimalPlaces[T](max))
+	}
+	return nv
+}
+
 func (nv NumberValidator[T]) With(fns ...func(n T) error) NumberValidator[T] {
 	if nv.scope.Ok() {
-		slices.Grow(nv.rules, len(fns))
+		nv.rules = slices.Grow(nv.rules, len(fns))
 		for _, fn := range fns {
 			nv.rules = append(nv.rules, NumberRuleFunc[T](fn))
 		}
@@ -0,0 +1,55 @@
+package validation
+
+type optionalRule[T any] struct {
+	rules []AnyRule[T]
+}
+
+func Optional[T any](rules ...AnyRule[T]) optionalRule[T] {
+	return optionalRule[T]{
+		rules: rules,
+	}
+}
+
+func (r optionalRule[T]) Validate(p *T) error {
+	if p == nil {
+		return nil
+	}
+	for _, rule := range r.rules {
+		if err := rule.Validate(*p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func OptionalCustom[T any, P interface {
+	*T
+	Validatable
+}](p P) error {
+	if p == nil {
+		return nil
+	}
+	return p.Validate()
+}
+
+type optionalNullableRule[T any] struct {
+	rules []AnyRule[T]
+}
+
+func OptionalNullable[T any](rules ...AnyRule[T]) optionalNullableRule[T] {
+	return optionalNullableRule[T]{
+		rules: rules,
+	}
+}
+
+func (r optionalNullableRule[T]) Validate(v T, valid bool) error {
+	if !valid {
+		return nil
+	}
+	for _, rule := range r.rules {
+		if err := rule.Validate(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation"
+)
+
+type optionalNested struct {
+	Name string
+}
+
+func (n *optionalNested) Validate() error {
+	return validation.All(
+		validation.String(n.Name, "name").Required(true),
+	)
+}
+
+type optionalPatch struct {
+	Title    *string
+	Count    *int
+	Deadline *time.Time
+	Nested   *optionalNested
+}
+
+func (p *optionalPatch) Validate() error {
+	return validation.All(
+		validation.Ptr(p.Title, "title").By(validation.Optional[string](validation.StringV[string]().Length(1, 10))),
+		validation.Ptr(p.Count, "count").ValueBy(validation.NumberV[int]().BetweenEqual(1, 100)),
+		validation.Ptr(p.Deadline, "deadline").ValueBy(validation.TimeV().Required(true)),
+		validation.Ptr(p.Nested, "nested").With(validation.OptionalCustom),
+	)
+}
+
+func Test_Optional_Validate(t *testing.T) {
+	var (
+		title = "hello"
+		empty = ""
+		count = 1000
+		zero  = time.Time{}
+	)
+	tests := []struct {
+		name  string
+		patch optionalPatch
+		want  string
+	}{
+		{"all nil", optionalPatch{}, ""},
+		{"valid", optionalPatch{Title: &title, Nested: &optionalNested{Name: "foo"}}, ""},
+		{"invalid title", optionalPatch{Title: &empty}, "title: the length must be between 1 and 10"},
+		{"invalid count", optionalPatch{Count: &count}, "count: must inclusively be between 1 and 100"},
+		{"invalid deadline", optionalPatch{Deadline: &zero}, "deadline: cannot be blank"},
+		{"invalid nested", optionalPatch{Nested: &optionalNested{}}, "nested: (name: cannot be blank)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.patch.Validate(); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("Optional.Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_PtrValidator_NotNil(t *testing.T) {
+	err := validation.PtrI[int](nil).NotNil(true).ValueWith(func(int) error { return nil }).Valid()
+	if err != validation.ErrNotNil {
+		t.Errorf("PtrValidator.Valid() = %v, want %v", err, validation.ErrNotNil)
+	}
+}
@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/infastin/go-validation/constraints"
+)
+
+var (
+	ErrSorted             = NewRuleError("sorted", "must be no less than the previous element")
+	ErrStrictlyIncreasing = NewRuleError("strictly_increasing", "must be greater than the previous element")
+	ErrIntervalInvalid    = NewRuleError("interval_invalid", "the start must be no greater than the end")
+	ErrIntervalOverlap    = NewRuleError("interval_overlap", "must not overlap with other intervals")
+)
+
+type orderSliceRule[T any] struct {
+	cmp    func(a, b T) int
+	strict bool
+}
+
+func SortedSlice[T constraints.Ordered]() orderSliceRule[T] {
+	return SortedSliceAny(cmp.Compare[T])
+}
+
+func SortedSliceAny[T any](cmp func(a, b T) int) orderSliceRule[T] {
+	return orderSliceRule[T]{
+		cmp:    cmp,
+		strict: false,
+	}
+}
+
+func StrictlyIncreasingSlice[T constraints.Ordered]() orderSliceRule[T] {
+	return StrictlyIncreasingSliceAny(cmp.Compare[T])
+}
+
+func StrictlyIncreasingSliceAny[T any](cmp func(a, b T) int) orderSliceRule[T] {
+	return orderSliceRule[T]{
+		cmp:    cmp,
+		strict: true,
+	}
+}
+
+func (r orderSliceRule[T]) Validate(s []T) error {
+	for i := 1; i < len(s); i++ {
+		c := r.cmp(s[i], s[i-1])
+		if c < 0 {
+			if r.strict {
+				return NewIndexError(i, ErrStrictlyIncreasing)
+			}
+			return NewIndexError(i, ErrSorted)
+		}
+		if c == 0 && r.strict {
+			return NewIndexError(i, ErrStrictlyIncreasing)
+		}
+	}
+	return nil
+}
+
+type nonOverlappingSliceRule[T, E any] struct {
+	cmp   func(a, b E) int
+	start func(v T) E
+	end   func(v T) E
+}
+
+// NonOverlappingSlice checks that the intervals described by start and end
+// do not overlap. Methods cannot have type parameters, so there is no
+// SliceValidator builder for it: use By(NonOverlappingSlice(start, end)).
+func NonOverlappingSlice[T any, E constraints.Ordered](start, end func(v T) E) nonOverlappingSliceRule[T, E] {
+	return NonOverlappingSliceAny(cmp.Compare[E], start, end)
+}
+
+func NonOverlappingSliceAny[T, E any](cmp func(a, b E) int, start, end func(v T) E) nonOverlappingSliceRule[T, E] {
+	return nonOverlappingSliceRule[T, E]{
+		cmp:   cmp,
+		start: start,
+		end:   end,
+	}
+}
+
+func (r nonOverlappingSliceRule[T, E]) Validate(s []T) error {
+	indices := make([]int, len(s))
+	for i := range s {
+		if r.cmp(r.start(s[i]), r.end(s[i])) > 0 {
+			return NewIndexError(i, ErrIntervalInvalid)
+		}
+		indices[i] = i
+	}
+
+	slices.SortStableFunc(indices, func(i, j int) int {
+		return r.cmp(r.start(s[i]), r.start(s[j]))
+	})
+
+	last := -1
+	for _, i := range indices {
+		if last != -1 && r.cmp(r.start(s[i]), r.end(s[last])) < 0 {
+			return NewIndexError(max(i, last), ErrIntervalOverlap)
+		}
+		if last == -1 || r.cmp(r.end(s[i]), r.end(s[last])) > 0 {
+			last = i
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_SortedSlice_Validate(t *testing.T) {
+	type params struct {
+		strict bool
+		slice  []int
+	}
+	tests := []struct {
+		name   string
+		params params
+		index  int
+		want   error
+	}{
+		{"empty", params{false, nil}, 0, nil},
+		{"sorted", params{false, []int{1, 2, 2, 3}}, 0, nil},
+		{"not sorted", params{false, []int{1, 3, 2, 0}}, 2, validation.ErrSorted},
+		{"strictly increasing", params{true, []int{1, 2, 3}}, 0, nil},
+		{"not strictly increasing", params{true, []int{1, 2, 2, 3}}, 2, validation.ErrStrictlyIncreasing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got error
+			if tt.params.strict {
+				got = validation.StrictlyIncreasingSlice[int]().Validate(tt.params.slice)
+			} else {
+				got = validation.SortedSlice[int]().Validate(tt.params.slice)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("SortedSlice.Validate() = %v, want nil", got)
+				}
+				return
+			}
+			var ie validation.IndexError
+			if !errors.As(got, &ie) || ie.Index() != tt.index || ie.Unwrap() != tt.want {
+				t.Errorf("SortedSlice.Validate() = %v, want [%d]: %v", got, tt.index, tt.want)
+			}
+		})
+	}
+}
+
+func Test_NonOverlappingSlice_Validate(t *testing.T) {
+	type interval struct {
+		from, to int
+	}
+	tests := []struct {
+		name  string
+		slice []interval
+		index int
+		want  error
+	}{
+		{"empty", nil, 0, nil},
+		{"adjacent", []interval{{0, 100}, {100, 200}, {200, 300}}, 0, nil},
+		{"unordered", []interval{{200, 300}, {0, 100}, {100, 200}}, 0, nil},
+		{"overlap", []interval{{0, 100}, {150, 300}, {90, 150}}, 2, validation.ErrIntervalOverlap},
+		{"nested", []interval{{0, 300}, {100, 200}}, 1, validation.ErrIntervalOverlap},
+		{"invalid", []interval{{0, 100}, {300, 200}}, 1, validation.ErrIntervalInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := validation.NonOverlappingSlice(
+				func(v interval) int { return v.from },
+				func(v interval) int { return v.to },
+			)
+			got := r.Validate(tt.slice)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("NonOverlappingSlice.Validate() = %v, want nil", got)
+				}
+				return
+			}
+			var ie validation.IndexError
+			if !errors.As(got, &ie) || ie.Index() != tt.index || ie.Unwrap() != tt.want {
+				t.Errorf("NonOverlappingSlice.Validate() = %v, want [%d]: %v", got, tt.index, tt.want)
+			}
+		})
+	}
+}
@@ -94,7 +94,7 @@ func (pv PtrValidator[T]) Nil(condition bool) PtrValidator[T] {
 
 func (pv PtrValidator[T]) With(fns ...func(p *T) error) PtrValidator[T] {
 	if pv.scope.Ok() {
-		slices.Grow(pv.rules, len(fns))
+		pv.rules = slices.Grow(pv.rules, len(fns))
 		for _, fn := range fns {
 			pv.rules = append(pv.rules, PtrRuleFunc[T](fn))
 		}
@@ -111,28 +111,18 @@ func (pv PtrValidator[T]) By(rules ...PtrRule[T]) PtrValidator[T] {
 
 func (pv PtrValidator[T]) ValueBy(rules ...AnyRule[T]) PtrValidator[T] {
 	if pv.scope.Ok() {
-		pv.rules = append(pv.rules, PtrRuleFunc[T](func(p *T) error {
-			for _, rule := range rules {
-				if err := rule.Validate(*p); err != nil {
-					return err
-				}
-			}
-			return nil
-		}))
+		pv.rules = append(pv.rules, Optional(rules...))
 	}
 	return pv
 }
 
 func (pv PtrValidator[T]) ValueWith(fns ...func(p T) error) PtrValidator[T] {
 	if pv.scope.Ok() {
-		pv.rules = append(pv.rules, PtrRuleFunc[T](func(p *T) error {
-			for _, fn := range fns {
-				if err := fn(*p); err != nil {
-					return err
-				}
-			}
-			return nil
-		}))
+		rules := make([]AnyRule[T], 0, len(fns))
+		for _, fn := range fns {
+			rules = append(rules, AnyRuleFunc[T](fn))
+		}
+		pv.rules = append(pv.rules, Optional(rules...))
 	}
 	return pv
 }
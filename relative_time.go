@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrPast   = NewRuleError("past", "must be in the past")
+	ErrFuture = NewRuleError("future", "must be in the future")
+)
+
+type relativeTimeRule struct {
+	clock      Clock
+	comp       func(x, now time.Time) bool
+	buildError func() error
+}
+
+func Past(clock Clock) relativeTimeRule {
+	return relativeTimeRule{
+		clock: clock,
+		comp: func(x, now time.Time) bool {
+			return x.Before(now)
+		},
+		buildError: func() error {
+			return ErrPast
+		},
+	}
+}
+
+func Future(clock Clock) relativeTimeRule {
+	return relativeTimeRule{
+		clock: clock,
+		comp: func(x, now time.Time) bool {
+			return x.After(now)
+		},
+		buildError: func() error {
+			return ErrFuture
+		},
+	}
+}
+
+func NotOlderThan(clock Clock, d time.Duration) relativeTimeRule {
+	return relativeTimeRule{
+		clock: clock,
+		comp: func(x, now time.Time) bool {
+			return !x.Before(now.Add(-d))
+		},
+		buildError: func() error {
+			return buildNotOlderThanError(d)
+		},
+	}
+}
+
+func NotFurtherThan(clock Clock, d time.Duration) relativeTimeRule {
+	return relativeTimeRule{
+		clock: clock,
+		comp: func(x, now time.Time) bool {
+			return !x.After(now.Add(d))
+		},
+		buildError: func() error {
+			return buildNotFurtherThanError(d)
+		},
+	}
+}
+
+func WithinOf(clock Clock, d time.Duration) relativeTimeRule {
+	return relativeTimeRule{
+		clock: clock,
+		comp: func(x, now time.Time) bool {
+			return !x.Before(now.Add(-d)) && !x.After(now.Add(d))
+		},
+		buildError: func() error {
+			return buildWithinOfError(d)
+		},
+	}
+}
+
+func MinAge(clock Clock, years int) relativeTimeRule {
+	return relativeTimeRule{
+		clock: clock,
+		comp: func(x, now time.Time) bool {
+			return !x.AddDate(years, 0, 0).After(now)
+		},
+		buildError: func() error {
+			return buildMinAgeError(years)
+		},
+	}
+}
+
+func MaxAge(clock Clock, years int) relativeTimeRule {
+	return relativeTimeRule{
+		clock: clock,
+		comp: func(x, now time.Time) bool {
+			return x.AddDate(years+1, 0, 0).After(now)
+		},
+		buildError: func() error {
+			return buildMaxAgeError(years)
+		},
+	}
+}
+
+func (r relativeTimeRule) Validate(t time.Time) error {
+	if !r.comp(t, now(r.clock)) {
+		return r.buildError()
+	}
+	return nil
+}
+
+func buildNotOlderThanError(d time.Duration) error {
+	return NewRuleError("not_older_than", fmt.Sprintf("must not be older than %v", d))
+}
+
+func buildNotFurtherThanError(d time.Duration) error {
+	return NewRuleError("not_further_than", fmt.Sprintf("must not be further than %v in the future", d))
+}
+
+func buildWithinOfError(d time.Duration) error {
+	return NewRuleError("within_of", fmt.Sprintf("must be within %v of the current time", d))
+}
+
+func buildMinAgeError(years int) error {
+	return NewRuleError("min_age", "the age must be no less than "+strconv.Itoa(years)+" years")
+}
+
+func buildMaxAgeError(years int) error {
+	return NewRuleError("max_age", "the age must be no more than "+strconv.Itoa(years)+" years")
+}
@@ -0,0 +1,49 @@
+package validation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_RelativeTime_Validate(t *testing.T) {
+	var (
+		now   = time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)
+		clock = validation.FixedClock(now)
+		tv    = validation.TimeV().Clock(clock)
+	)
+	tests := []struct {
+		name      string
+		validator validation.TimeValidator
+		value     time.Time
+		want      string
+	}{
+		{"past", tv.Past(), now.Add(-time.Second), ""},
+		{"not past", tv.Past(), now, "must be in the past"},
+		{"future", tv.Future(), now.Add(time.Second), ""},
+		{"not future", tv.Future(), now.Add(-time.Second), "must be in the future"},
+		{"not older", tv.NotOlderThan(time.Hour), now.Add(-time.Hour), ""},
+		{"older", tv.NotOlderThan(time.Hour), now.Add(-time.Hour - 1), "must not be older than 1h0m0s"},
+		{"not further", tv.NotFurtherThan(time.Hour), now.Add(time.Hour), ""},
+		{"further", tv.NotFurtherThan(time.Hour), now.Add(2 * time.Hour), "must not be further than 1h0m0s in the future"},
+		{"within", tv.WithinOf(time.Minute), now.Add(-30 * time.Second), ""},
+		{"not within", tv.WithinOf(time.Minute), now.Add(2 * time.Minute), "must be within 1m0s of the current time"},
+		{"adult", tv.MinAge(18), time.Date(2006, 2, 28, 0, 0, 0, 0, time.UTC), ""},
+		{"minor", tv.MinAge(18), time.Date(2006, 3, 1, 0, 0, 0, 0, time.UTC), "the age must be no less than 18 years"},
+		{"leap day birth", tv.MinAge(4), time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), ""},
+		{"max age", tv.MaxAge(17), time.Date(2006, 3, 1, 0, 0, 0, 0, time.UTC), ""},
+		{"above max age", tv.MaxAge(17), time.Date(2006, 2, 28, 0, 0, 0, 0, time.UTC), "the age must be no more than 17 years"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			if err := tt.validator.Validate(tt.value); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("TimeValidator.Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package validation
 
 import (
 	"time"
+
+	"github.com/infastin/go-validation/civil"
+	"github.com/infastin/go-validation/constraints"
 )
 
 type requiredRule[T comparable] struct {
@@ -43,6 +46,57 @@ func (r requiredTimeRule) Validate(v time.Time) error {
 	return nil
 }
 
+type requiredDateRule struct {
+	condition bool
+}
+
+func RequiredDate(condition bool) requiredDateRule {
+	return requiredDateRule{
+		condition: condition,
+	}
+}
+
+func (r requiredDateRule) Validate(d civil.Date) error {
+	if r.condition && d.IsZero() {
+		return ErrRequired
+	}
+	return nil
+}
+
+type requiredBigRule[T constraints.Big[T]] struct {
+	condition bool
+}
+
+func RequiredBig[T constraints.Big[T]](condition bool) requiredBigRule[T] {
+	return requiredBigRule[T]{
+		condition: condition,
+	}
+}
+
+func (r requiredBigRule[T]) Validate(v T) error {
+	if r.condition && (v == nil || v.Sign() == 0) {
+		return ErrRequired
+	}
+	return nil
+}
+
+type requiredNullableRule[T any] struct {
+	condition bool
+}
+
+func RequiredNullable[T any](condition bool) requiredNullableRule[T] {
+	return requiredNullableRule[T]{
+		condition: condition,
+	}
+}
+
+func (r requiredNullableRule[T]) Validate(_ T, valid bool) error {
+	if r.condition && !valid {
+		return ErrRequired
+	}
+	return nil
+}
+
 type requiredSliceRule[T any] struct {
 	condition bool
 	skipNil   bool
@@ -0,0 +1,125 @@
+package validation
+
+import "github.com/infastin/go-validation/constraints"
+
+var (
+	ErrPositive    = NewRuleError("positive", "must be positive")
+	ErrNegative    = NewRuleError("negative", "must be negative")
+	ErrNonZero     = NewRuleError("non_zero", "must not be zero")
+	ErrNonNegative = NewRuleError("non_negative", "must not be negative")
+	ErrNonPositive = NewRuleError("non_positive", "must not be positive")
+)
+
+type signRule[T constraints.Number] struct {
+	check func(n T) bool
+	err   error
+}
+
+func Positive[T constraints.Number]() signRule[T] {
+	return signRule[T]{
+		check: func(n T) bool {
+			return n > 0
+		},
+		err: ErrPositive,
+	}
+}
+
+func Negative[T constraints.Number]() signRule[T] {
+	return signRule[T]{
+		check: func(n T) bool {
+			return n < 0
+		},
+		err: ErrNegative,
+	}
+}
+
+func NonZero[T constraints.Number]() signRule[T] {
+	return signRule[T]{
+		check: func(n T) bool {
+			return n != 0
+		},
+		err: ErrNonZero,
+	}
+}
+
+func NonNegative[T constraints.Number]() signRule[T] {
+	return signRule[T]{
+		check: func(n T) bool {
+			return n >= 0
+		},
+		err: ErrNonNegative,
+	}
+}
+
+func NonPositive[T constraints.Number]() signRule[T] {
+	return signRule[T]{
+		check: func(n T) bool {
+			return n <= 0
+		},
+		err: ErrNonPositive,
+	}
+}
+
+func (r signRule[T]) Validate(n T) error {
+	if !r.check(n) {
+		return r.err
+	}
+	return nil
+}
+
+type signBigRule[T constraints.Big[T]] struct {
+	check func(sign int) bool
+	err   error
+}
+
+func PositiveBig[T constraints.Big[T]]() signBigRule[T] {
+	return signBigRule[T]{
+		check: func(sign int) bool {
+			return sign > 0
+		},
+		err: ErrPositive,
+	}
+}
+
+func NegativeBig[T constraints.Big[T]]() signBigRule[T] {
+	return signBigRule[T]{
+		check: func(sign int) bool {
+			return sign < 0
+		},
+		err: ErrNegative,
+	}
+}
+
+func NonZeroBig[T constraints.Big[T]]() signBigRule[T] {
+	return signBigRule[T]{
+		check: func(sign int) bool {
+			return sign != 0
+		},
+		err: ErrNonZero,
+	}
+}
+
+func NonNegativeBig[T constraints.Big[T]]() signBigRule[T] {
+	return signBigRule[T]{
+		check: func(sign int) bool {
+			return sign >= 0
+		},
+		err: ErrNonNegative,
+	}
+}
+
+func NonPositiveBig[T constraints.Big[T]]() signBigRule[T] {
+	return signBigRule[T]{
+		check: func(sign int) bool {
+			return sign <= 0
+		},
+		err: ErrNonPositive,
+	}
+}
+
+func (r signBigRule[T]) Validate(n T) error {
+	if n != nil && !r.check(n.Sign()) {
+		return r.err
+	}
+	return nil
+}
@@ -120,9 +120,72 @@ func (sv SliceValidator[T]) Length(min, max int) SliceValidator[T] {
 	return sv
 }
 
+func (sv SliceValidator[T]) Contains(eq func(a, b T) bool, v T) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, ContainsSliceAny(eq, v))
+	}
+	return sv
+}
+
+func (sv SliceValidator[T]) ContainsAll(eq func(a, b T) bool, values ...T) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, ContainsAllSliceAny(eq, values...))
+	}
+	return sv
+}
+
+func (sv SliceValidator[T]) ContainsAny(eq func(a, b T) bool, values ...T) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, ContainsAnySliceAny(eq, values...))
+	}
+	return sv
+}
+
+func (sv SliceValidator[T]) SubsetOf(eq func(a, b T) bool, allowed ...T) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, SubsetOfSliceAny(eq, allowed...))
+	}
+	return sv
+}
+
+func (sv SliceValidator[T]) Disjoint(eq func(a, b T) bool, forbidden ...T) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, DisjointSliceAny(eq, forbidden...))
+	}
+	return sv
+}
+
+// SortedBy checks that the elements are in ascending order according to cmp.
+// For ordered types, use By(SortedSlice[T]()) instead.
+func (sv SliceValidator[T]) SortedBy(cmp func(a, b T) int) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, SortedSliceAny(cmp))
+	}
+	return sv
+}
+
+// StrictlyIncreasing checks that every element is greater than the previous one
+// according to cmp. For ordered types, use By(StrictlyIncreasingSlice[T]()) instead.
+func (sv SliceValidator[T]) StrictlyIncreasing(cmp func(a, b T) int) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, StrictlyIncreasingSliceAny(cmp))
+	}
+	return sv
+}
+
+// CountWhere checks the number of elements that satisfy pred.
+// Sums, minimums and maximums need another type parameter,
+// so they are added with By(SumSlice(...)), By(MinSlice(...)) or By(MaxSlice(...)).
+func (sv SliceValidator[T]) CountWhere(pred func(v T) bool, rules ...NumberRule[int]) SliceValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, CountSlice(pred, rules...))
+	}
+	return sv
+}
+
 func (sv SliceValidator[T]) With(fns ...func(s []T) error) SliceValidator[T] {
 	if sv.scope.Ok() {
-		slices.Grow(sv.rules, len(fns))
+		sv.rules = slices.Grow(sv.rules, len(fns))
 		for _, fn := range fns {
 			sv.rules = append(sv.rules, SliceRuleFunc[T](fn))
 		}
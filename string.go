@@ -92,6 +92,13 @@ func (sv StringValidator[T]) Length(min, max int) StringValidator[T] {
 	return sv
 }
 
+func (sv StringValidator[T]) LengthRune(min, max int) StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, LengthStringRune[T](min, max))
+	}
+	return sv
+}
+
 func (sv StringValidator[T]) In(elements ...T) StringValidator[T] {
 	if sv.scope.Ok() {
 		sv.rules = append(sv.rules, In(elements...))
@@ -155,9 +162,58 @@ func (sv StringValidator[T]) BetweenEqual(a, b T) StringValidator[T] {
 	return sv
 }
 
+func (sv StringValidator[T]) HasPrefix(prefix T) StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, HasPrefixString(prefix))
+	}
+	return sv
+}
+
+func (sv StringValidator[T]) HasSuffix(suffix T) StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, HasSuffixString(suffix))
+	}
+	return sv
+}
+
+func (sv StringValidator[T]) Contains(substr T) StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, ContainsString(substr))
+	}
+	return sv
+}
+
+func (sv StringValidator[T]) NotContains(substr T) StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, NotContainsString(substr))
+	}
+	return sv
+}
+
+func (sv StringValidator[T]) ContainsAny(chars string) StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, ContainsAnyString[T](chars))
+	}
+	return sv
+}
+
+func (sv StringValidator[T]) OnlyRunes(set string) StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, OnlyRunesString[T](set))
+	}
+	return sv
+}
+
+func (sv StringValidator[T]) Trimmed() StringValidator[T] {
+	if sv.scope.Ok() {
+		sv.rules = append(sv.rules, TrimmedString[T]())
+	}
+	return sv
+}
+
 func (sv StringValidator[T]) With(fns ...func(s T) error) StringValidator[T] {
 	if sv.scope.Ok() {
-		slices.Grow(sv.rules, len(fns))
+		sv.rules = slices.Grow(sv.rules, len(fns))
 		for _, fn := range fns {
 			sv.rules = append(sv.rules, StringRuleFunc[T](fn))
 		}
@@ -0,0 +1,100 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var ErrTrimmed = NewRuleError("trimmed", "must not have leading or trailing whitespace")
+
+type substringRule[T ~string] struct {
+	valid      func(s string) bool
+	buildError func() error
+}
+
+func HasPrefixString[T ~string](prefix T) substringRule[T] {
+	return substringRule[T]{
+		valid: func(s string) bool {
+			return strings.HasPrefix(s, string(prefix))
+		},
+		buildError: func() error {
+			return NewRuleError("has_prefix", fmt.Sprintf("must start with %q", prefix))
+		},
+	}
+}
+
+func HasSuffixString[T ~string](suffix T) substringRule[T] {
+	return substringRule[T]{
+		valid: func(s string) bool {
+			return strings.HasSuffix(s, string(suffix))
+		},
+		buildError: func() error {
+			return NewRuleError("has_suffix", fmt.Sprintf("must end with %q", suffix))
+		},
+	}
+}
+
+func ContainsString[T ~string](substr T) substringRule[T] {
+	return substringRule[T]{
+		valid: func(s string) bool {
+			return strings.Contains(s, string(substr))
+		},
+		buildError: func() error {
+			return NewRuleError("contains_substring", fmt.Sprintf("must contain %q", substr))
+		},
+	}
+}
+
+func NotContainsString[T ~string](substr T) substringRule[T] {
+	return substringRule[T]{
+		valid: func(s string) bool {
+			return !strings.Contains(s, string(substr))
+		},
+		buildError: func() error {
+			return NewRuleError("not_contains_substring", fmt.Sprintf("must not contain %q", substr))
+		},
+	}
+}
+
+func ContainsAnyString[T ~string](chars string) substringRule[T] {
+	return substringRule[T]{
+		valid: func(s string) bool {
+			return strings.ContainsAny(s, chars)
+		},
+		buildError: func() error {
+			return NewRuleError("contains_any_rune", fmt.Sprintf("must contain at least one of the characters %q", chars))
+		},
+	}
+}
+
+func OnlyRunesString[T ~string](set string) substringRule[T] {
+	return substringRule[T]{
+		valid: func(s string) bool {
+			return strings.IndexFunc(s, func(r rune) bool {
+				return !strings.ContainsRune(set, r)
+			}) == -1
+		},
+		buildError: func() error {
+			return NewRuleError("only_runes", fmt.Sprintf("must contain only the characters %q", set))
+		},
+	}
+}
+
+func TrimmedString[T ~string]() substringRule[T] {
+	return substringRule[T]{
+		valid: func(s string) bool {
+			return strings.TrimFunc(s, unicode.IsSpace) == s
+		},
+		buildError: func() error {
+			return ErrTrimmed
+		},
+	}
+}
+
+func (r substringRule[T]) Validate(v T) error {
+	if !r.valid(string(v)) {
+		return r.buildError()
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/infastin/go-validation"
+)
+
+func Test_StringValidator_Substring(t *testing.T) {
+	tests := []struct {
+		name     string
+		sv       validation.StringValidator[string]
+		value    string
+		wantCode string
+		want     string
+	}{
+		{"prefix", validation.StringV[string]().HasPrefix("sk_"), "sk_live", "", ""},
+		{"no prefix", validation.StringV[string]().HasPrefix("sk_"), "pk_live", "has_prefix", `must start with "sk_"`},
+		{"suffix", validation.StringV[string]().HasSuffix(".csv"), "report.csv", "", ""},
+		{"no suffix", validation.StringV[string]().HasSuffix(".csv"), "report.xls", "has_suffix", `must end with ".csv"`},
+		{"contains", validation.StringV[string]().Contains("@"), "a@b", "", ""},
+		{"not contains", validation.StringV[string]().Contains("@"), "ab", "contains_substring", `must contain "@"`},
+		{"forbidden", validation.StringV[string]().NotContains(".."), "a/../b", "not_contains_substring", `must not contain ".."`},
+		{"allowed", validation.StringV[string]().NotContains(".."), "a/b", "", ""},
+		{"contains any", validation.StringV[string]().ContainsAny("!@#"), "pa#ss", "", ""},
+		{"contains none", validation.StringV[string]().ContainsAny("!@#"), "pass", "contains_any_rune", `must contain at least one of the characters "!@#"`},
+		{"only runes", validation.StringV[string]().OnlyRunes("01"), "0110", "", ""},
+		{"only runes empty", validation.StringV[string]().OnlyRunes("01"), "", "", ""},
+		{"other runes", validation.StringV[string]().OnlyRunes("01"), "0120", "only_runes", `must contain only the characters "01"`},
+		{"only runes unicode", validation.StringV[string]().OnlyRunes("äö"), "öä", "", ""},
+		{"trimmed", validation.StringV[string]().Trimmed(), "a b", "", ""},
+		{"leading space", validation.StringV[string]().Trimmed(), " a", "trimmed", "must not have leading or trailing whitespace"},
+		{"trailing newline", validation.StringV[string]().Trimmed(), "a\n", "trimmed", "must not have leading or trailing whitespace"},
+		{"rune length", validation.StringV[string]().LengthRune(1, 3), "äöü", "", ""},
+		{"rune length too long", validation.StringV[string]().LengthRune(1, 2), "äöü", "length_out_of_range", "the length must be between 1 and 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sv.Validate(tt.value)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Errorf("StringValidator.Validate(%q) = %v, want nil", tt.value, err)
+				}
+				return
+			}
+			re, ok := err.(validation.RuleError)
+			if !ok || re.Code() != tt.wantCode || re.Message() != tt.want {
+				t.Errorf("StringValidator.Validate(%q) = %v, want %s: %s", tt.value, err, tt.wantCode, tt.want)
+			}
+		})
+	}
+}
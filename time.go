@@ -14,6 +14,7 @@ type TimeValidator struct {
 	data  *timeValidatorData
 	rules []TimeRule
 	scope validatorScope
+	clock Clock
 }
 
 func Time(v time.Time, name string) TimeValidator {
@@ -24,6 +25,7 @@ func Time(v time.Time, name string) TimeValidator {
 		},
 		rules: make([]TimeRule, 0),
 		scope: nil,
+		clock: nil,
 	}
 }
 
@@ -35,6 +37,7 @@ func TimeI(v time.Time) TimeValidator {
 		},
 		rules: make([]TimeRule, 0),
 		scope: nil,
+		clock: nil,
 	}
 }
 
@@ -43,6 +46,7 @@ func TimeV() TimeValidator {
 		data:  nil,
 		rules: make([]TimeRule, 0),
 		scope: nil,
+		clock: nil,
 	}
 }
 
@@ -81,6 +85,11 @@ func (tv TimeValidator) EndIf() TimeValidator {
 	return tv
 }
 
+func (tv TimeValidator) Clock(clock Clock) TimeValidator {
+	tv.clock = clock
+	return tv
+}
+
 func (tv TimeValidator) Required(condition bool) TimeValidator {
 	if tv.scope.Ok() {
 		tv.rules = append(tv.rules, RequiredTime(condition))
@@ -151,9 +160,114 @@ func (tv TimeValidator) BetweenEqual(a, b time.Time) TimeValidator {
 	return tv
 }
 
+func (tv TimeValidator) Past() TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, Past(tv.clock))
+	}
+	return tv
+}
+
+func (tv TimeValidator) Future() TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, Future(tv.clock))
+	}
+	return tv
+}
+
+func (tv TimeValidator) NotOlderThan(d time.Duration) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, NotOlderThan(tv.clock, d))
+	}
+	return tv
+}
+
+func (tv TimeValidator) NotFurtherThan(d time.Duration) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, NotFurtherThan(tv.clock, d))
+	}
+	return tv
+}
+
+func (tv TimeValidator) WithinOf(d time.Duration) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, WithinOf(tv.clock, d))
+	}
+	return tv
+}
+
+func (tv TimeValidator) MinAge(years int) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, MinAge(tv.clock, years))
+	}
+	return tv
+}
+
+func (tv TimeValidator) MaxAge(years int) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, MaxAge(tv.clock, years))
+	}
+	return tv
+}
+
+func (tv TimeValidator) Weekday(loc *time.Location) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, WeekdayTime(loc))
+	}
+	return tv
+}
+
+func (tv TimeValidator) DaysOfWeek(loc *time.Location, days ...time.Weekday) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, DaysOfWeekTime(loc, days...))
+	}
+	return tv
+}
+
+func (tv TimeValidator) TimeOfDay(loc *time.Location, from, to time.Duration) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, TimeOfDayTime(loc, from, to))
+	}
+	return tv
+}
+
+func (tv TimeValidator) Aligned(loc *time.Location, d time.Duration) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, AlignedTime(loc, d))
+	}
+	return tv
+}
+
+func (tv TimeValidator) NotUTC() TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, NotUTCTime())
+	}
+	return tv
+}
+
+func (tv TimeValidator) SameDay(v time.Time, loc *time.Location) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, SameDayTime(v, loc))
+	}
+	return tv
+}
+
+func (tv TimeValidator) EqualTruncated(v time.Time, d time.Duration) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, EqualTruncatedTime(v, d))
+	}
+	return tv
+}
+
+func (tv TimeValidator) AsDate(loc *time.Location, rules ...DateRule) TimeValidator {
+	if tv.scope.Ok() {
+		tv.rules = append(tv.rules, AsDateTime(loc, rules...))
+	}
+	return tv
+}
+
 func (tv TimeValidator) With(fns ...func(v time.Time) error) TimeValidator {
 	if tv.scope.Ok() {
-		slices.Grow(tv.rules, len(fns))
+		tv.rules = slices.Grow(tv.rules, len(fns))
 		for _, fn := range fns {
 			tv.rules = append(tv.rules, TimeRuleFunc(fn))
 		}
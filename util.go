@@ -1,5 +1,11 @@
 package validation
 
+import (
+	"math/big"
+
+	"github.com/infastin/go-validation/constraints"
+)
+
 type validatorScope []bool
 
 func (s validatorScope) Ok() bool {
@@ -21,3 +27,26 @@ func (s validatorScope) Push(condition bool) validatorScope {
 func (s validatorScope) Pop() validatorScope {
 	return s[:len(s)-1]
 }
+
+func isFloat[T constraints.Number]() bool {
+	var x T = 1
+	return x/2 != 0
+}
+
+func isSigned[T constraints.Number]() bool {
+	var x T
+	x--
+	return x < 0
+}
+
+func formatBig(v any) string {
+	switch n := v.(type) {
+	case *big.Int:
+		return n.String()
+	case *big.Float:
+		return n.Text('g', -1)
+	case *big.Rat:
+		return n.RatString()
+	}
+	return ""
+}
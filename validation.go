@@ -3,6 +3,7 @@ package validation
 import (
 	"time"
 
+	"github.com/infastin/go-validation/civil"
 	"github.com/infastin/go-validation/constraints"
 )
 
@@ -30,6 +31,16 @@ func (fn NumberRuleFunc[T]) Validate(n T) error {
 	return fn(n)
 }
 
+type BigRule[T constraints.Big[T]] interface {
+	Validate(n T) error
+}
+
+type BigRuleFunc[T constraints.Big[T]] func(n T) error
+
+func (fn BigRuleFunc[T]) Validate(n T) error {
+	return fn(n)
+}
+
 type TimeRule interface {
 	Validate(t time.Time) error
 }
@@ -40,6 +51,26 @@ func (fn TimeRuleFunc) Validate(t time.Time) error {
 	return fn(t)
 }
 
+type DateRule interface {
+	Validate(d civil.Date) error
+}
+
+type DateRuleFunc func(d civil.Date) error
+
+func (fn DateRuleFunc) Validate(d civil.Date) error {
+	return fn(d)
+}
+
+type DurationRule interface {
+	Validate(d time.Duration) error
+}
+
+type DurationRuleFunc func(d time.Duration) error
+
+func (fn DurationRuleFunc) Validate(d time.Duration) error {
+	return fn(d)
+}
+
 type PtrRule[T any] interface {
 	Validate(p *T) error
 }
@@ -50,6 +81,16 @@ func (fn PtrRuleFunc[T]) Validate(p *T) error {
 	return fn(p)
 }
 
+type NullableRule[T any] interface {
+	Validate(v T, valid bool) error
+}
+
+type NullableRuleFunc[T any] func(v T, valid bool) error
+
+func (fn NullableRuleFunc[T]) Validate(v T, valid bool) error {
+	return fn(v, valid)
+}
+
 type SliceRule[T any] interface {
 	Validate(s []T) error
 }